@@ -0,0 +1,143 @@
+package emoji
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// AutocompleteIndex finds emojis whose names start with a prefix.
+//
+// It is intended for typeahead where a lookup happens on every keystroke.
+// Prefixes match the start of a name as well as the start of every word
+// within a name, e.g. "fa" matches "smiling_face".
+type AutocompleteIndex struct {
+	// entries are sorted by their term.
+	entries []autocompleteEntry
+}
+
+// autocompleteEntry is a word of a keyword along with the rest of the keyword.
+type autocompleteEntry struct {
+	// term is the suffix of the keyword starting at a word.
+	term string
+	// keyword is the complete name of the emoji.
+	keyword string
+	// offset is the rune offset of the term in the keyword.
+	offset int
+	// index of the emoji in All.
+	index int
+	// rank is the position of the entry when all entries are ordered from
+	// best to worst match for a prefix of the term.
+	rank int
+}
+
+// NewAutocompleteIndex creates a prefix index of the emoji names.
+func NewAutocompleteIndex() *AutocompleteIndex {
+	var entries []autocompleteEntry
+	for i, info := range All() {
+		for _, keyword := range info.AlternateNames {
+			keyword = strings.ToLower(keyword)
+			offset := 0
+			for pos := range keyword {
+				if pos == 0 || isWordStart(keyword, pos) {
+					entries = append(entries, autocompleteEntry{
+						term:    keyword[pos:],
+						keyword: keyword,
+						offset:  offset,
+						index:   i,
+					})
+				}
+				offset++
+			}
+		}
+	}
+
+	// matches at the start of a name rank before matches of a word within a name,
+	// then shorter names rank before longer names
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if (a.offset == 0) != (b.offset == 0) {
+			return a.offset == 0
+		}
+		return len(a.keyword) < len(b.keyword)
+	})
+	for i := range entries {
+		entries[i].rank = i
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].term < entries[j].term
+	})
+	return &AutocompleteIndex{entries: entries}
+}
+
+// Complete returns up to limit emojis with a name or a word of a name that
+// starts with the prefix. A limit of 0 means no limit.
+//
+// Emojis whose name starts with the prefix are ranked before emojis where only
+// a word within the name matches, and shorter names are ranked before longer names.
+// Every emoji is returned at most once.
+func (a *AutocompleteIndex) Complete(prefix string, limit int) []Result {
+	prefix = strings.ToLower(prefix)
+	if len(prefix) == 0 {
+		return nil
+	}
+	start := sort.Search(len(a.entries), func(i int) bool {
+		return a.entries[i].term >= prefix
+	})
+	var best []autocompleteEntry
+	for i := start; i < len(a.entries) && strings.HasPrefix(a.entries[i].term, prefix); i++ {
+		best = insertBest(best, a.entries[i], limit)
+	}
+
+	prefixLength := utf8.RuneCountInString(prefix)
+	results := make([]Result, 0, len(best))
+	for _, match := range best {
+		keywordLength := utf8.RuneCountInString(match.keyword)
+		results = append(results, Result{
+			Info:     All()[match.index],
+			Keyword:  match.keyword,
+			Distance: keywordLength - prefixLength,
+			Score:    float64(prefixLength) / float64(keywordLength),
+			Ranges:   []Range{{Start: match.offset, End: match.offset + prefixLength}},
+		})
+	}
+	return results
+}
+
+// insertBest adds the entry to the entries ordered by rank, keeping only the
+// best entry of every emoji and at most limit entries if the limit is not 0.
+func insertBest(best []autocompleteEntry, entry autocompleteEntry, limit int) []autocompleteEntry {
+	if limit > 0 && len(best) >= limit && entry.rank >= best[len(best)-1].rank {
+		return best
+	}
+	for i, other := range best {
+		if other.index != entry.index {
+			continue
+		}
+		if other.rank <= entry.rank {
+			return best
+		}
+		best = append(best[:i], best[i+1:]...)
+		break
+	}
+	pos := sort.Search(len(best), func(i int) bool {
+		return best[i].rank > entry.rank
+	})
+	best = append(best, autocompleteEntry{})
+	copy(best[pos+1:], best[pos:])
+	best[pos] = entry
+	if limit > 0 && len(best) > limit {
+		best = best[:limit]
+	}
+	return best
+}
+
+// isWordStart reports whether a new word starts at the byte position of the keyword.
+func isWordStart(keyword string, pos int) bool {
+	switch keyword[pos-1] {
+	case '_', '-', ' ':
+		return keyword[pos] != '_' && keyword[pos] != '-' && keyword[pos] != ' '
+	default:
+		return false
+	}
+}
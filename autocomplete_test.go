@@ -0,0 +1,91 @@
+package emoji
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAutocompleteIndex_Complete(t *testing.T) {
+	tests := []struct {
+		name   string
+		prefix string
+		limit  int
+		want   []string
+	}{
+		{
+			"name prefix",
+			"rock",
+			0,
+			[]string{"rock", "rocket"},
+		},
+		{
+			"word start",
+			"ocket",
+			0,
+			nil,
+		},
+		{
+			"name before word start",
+			"grin",
+			2,
+			[]string{"grin", "grinning"},
+		},
+		{
+			"case insensitive",
+			"ROCKET",
+			0,
+			[]string{"rocket"},
+		},
+		{
+			"empty prefix",
+			"",
+			0,
+			nil,
+		},
+	}
+	index := NewAutocompleteIndex()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, result := range index.Complete(tt.prefix, tt.limit) {
+				got = append(got, result.Keyword)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Complete() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAutocompleteIndex_CompleteWordStart(t *testing.T) {
+	// "fa" matches the start of the word "face" inside the name
+	results := NewAutocompleteIndex().Complete("fa", 0)
+	for _, result := range results {
+		if result.Keyword != "smiling_face_with_3_hearts" {
+			continue
+		}
+		if want := []Range{{8, 10}}; !reflect.DeepEqual(result.Ranges, want) {
+			t.Errorf("Complete() ranges = %v, want %v", result.Ranges, want)
+		}
+		return
+	}
+	t.Errorf("Complete() did not match smiling_face_with_3_hearts")
+}
+
+var benchmarkPrefixes = []string{"f", "fa", "roc", "smiling", "thumbs"}
+
+func BenchmarkAutocompleteIndex_Complete(b *testing.B) {
+	index := NewAutocompleteIndex()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		index.Complete(benchmarkPrefixes[i%len(benchmarkPrefixes)], 10)
+	}
+}
+
+func BenchmarkSearchIndex_Search(b *testing.B) {
+	index := NewSearchIndex(WithLimit(10))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		index.Search(benchmarkPrefixes[i%len(benchmarkPrefixes)])
+	}
+}
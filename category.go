@@ -0,0 +1,355 @@
+package emoji
+
+import "sort"
+
+// Category is the top-level group of an emoji.
+type Category string
+
+const (
+	// CategorySmileysAndEmotion contains faces, hearts and other emotions 😀.
+	CategorySmileysAndEmotion Category = "Smileys & Emotion"
+	// CategoryPeopleAndBody contains people, body parts and gestures 👋.
+	CategoryPeopleAndBody Category = "People & Body"
+	// CategoryComponent contains components such as skin tones 🏽.
+	CategoryComponent Category = "Component"
+	// CategoryAnimalsAndNature contains animals and plants 🐶.
+	CategoryAnimalsAndNature Category = "Animals & Nature"
+	// CategoryFoodAndDrink contains food, drinks and dishware 🍕.
+	CategoryFoodAndDrink Category = "Food & Drink"
+	// CategoryTravelAndPlaces contains places, transport and weather 🚀.
+	CategoryTravelAndPlaces Category = "Travel & Places"
+	// CategoryActivities contains events, sports and games ⚽.
+	CategoryActivities Category = "Activities"
+	// CategoryObjects contains clothing, tools and other objects 💡.
+	CategoryObjects Category = "Objects"
+	// CategorySymbols contains signs, arrows and other symbols ♻️.
+	CategorySymbols Category = "Symbols"
+	// CategoryFlags contains country and other flags 🏁.
+	CategoryFlags Category = "Flags"
+)
+
+// Subcategory is a group of closely related emojis within a category,
+// such as "face-smiling" or "transport-air".
+type Subcategory string
+
+// Subcategories of CategorySmileysAndEmotion.
+const (
+	SubcategoryFaceSmiling          Subcategory = "face-smiling"
+	SubcategoryFaceAffection        Subcategory = "face-affection"
+	SubcategoryFaceTongue           Subcategory = "face-tongue"
+	SubcategoryFaceHand             Subcategory = "face-hand"
+	SubcategoryFaceNeutralSkeptical Subcategory = "face-neutral-skeptical"
+	SubcategoryFaceSleepy           Subcategory = "face-sleepy"
+	SubcategoryFaceUnwell           Subcategory = "face-unwell"
+	SubcategoryFaceHat              Subcategory = "face-hat"
+	SubcategoryFaceGlasses          Subcategory = "face-glasses"
+	SubcategoryFaceConcerned        Subcategory = "face-concerned"
+	SubcategoryFaceNegative         Subcategory = "face-negative"
+	SubcategoryFaceCostume          Subcategory = "face-costume"
+	SubcategoryCatFace              Subcategory = "cat-face"
+	SubcategoryMonkeyFace           Subcategory = "monkey-face"
+	SubcategoryHeart                Subcategory = "heart"
+	SubcategoryEmotion              Subcategory = "emotion"
+)
+
+// Subcategories of CategoryPeopleAndBody.
+const (
+	SubcategoryHandFingersOpen    Subcategory = "hand-fingers-open"
+	SubcategoryHandFingersPartial Subcategory = "hand-fingers-partial"
+	SubcategoryHandSingleFinger   Subcategory = "hand-single-finger"
+	SubcategoryHandFingersClosed  Subcategory = "hand-fingers-closed"
+	SubcategoryHands              Subcategory = "hands"
+	SubcategoryHandProp           Subcategory = "hand-prop"
+	SubcategoryBodyParts          Subcategory = "body-parts"
+	SubcategoryPerson             Subcategory = "person"
+	SubcategoryPersonGesture      Subcategory = "person-gesture"
+	SubcategoryPersonRole         Subcategory = "person-role"
+	SubcategoryPersonFantasy      Subcategory = "person-fantasy"
+	SubcategoryPersonActivity     Subcategory = "person-activity"
+	SubcategoryPersonSport        Subcategory = "person-sport"
+	SubcategoryPersonResting      Subcategory = "person-resting"
+	SubcategoryFamily             Subcategory = "family"
+	SubcategoryPersonSymbol       Subcategory = "person-symbol"
+)
+
+// Subcategories of CategoryComponent.
+const (
+	SubcategorySkinTone Subcategory = "skin-tone"
+)
+
+// Subcategories of CategoryAnimalsAndNature.
+const (
+	SubcategoryAnimalMammal    Subcategory = "animal-mammal"
+	SubcategoryAnimalBird      Subcategory = "animal-bird"
+	SubcategoryAnimalAmphibian Subcategory = "animal-amphibian"
+	SubcategoryAnimalReptile   Subcategory = "animal-reptile"
+	SubcategoryAnimalMarine    Subcategory = "animal-marine"
+	SubcategoryAnimalBug       Subcategory = "animal-bug"
+	SubcategoryPlantFlower     Subcategory = "plant-flower"
+	SubcategoryPlantOther      Subcategory = "plant-other"
+)
+
+// Subcategories of CategoryFoodAndDrink.
+const (
+	SubcategoryFoodFruit     Subcategory = "food-fruit"
+	SubcategoryFoodVegetable Subcategory = "food-vegetable"
+	SubcategoryFoodPrepared  Subcategory = "food-prepared"
+	SubcategoryFoodAsian     Subcategory = "food-asian"
+	SubcategoryFoodMarine    Subcategory = "food-marine"
+	SubcategoryFoodSweet     Subcategory = "food-sweet"
+	SubcategoryDrink         Subcategory = "drink"
+	SubcategoryDishware      Subcategory = "dishware"
+)
+
+// Subcategories of CategoryTravelAndPlaces.
+const (
+	SubcategoryPlaceMap        Subcategory = "place-map"
+	SubcategoryPlaceGeographic Subcategory = "place-geographic"
+	SubcategoryPlaceBuilding   Subcategory = "place-building"
+	SubcategoryPlaceReligious  Subcategory = "place-religious"
+	SubcategoryPlaceOther      Subcategory = "place-other"
+	SubcategoryTransportGround Subcategory = "transport-ground"
+	SubcategoryTransportWater  Subcategory = "transport-water"
+	SubcategoryTransportAir    Subcategory = "transport-air"
+	SubcategoryHotel           Subcategory = "hotel"
+	SubcategoryTime            Subcategory = "time"
+	SubcategorySkyAndWeather   Subcategory = "sky & weather"
+)
+
+// Subcategories of CategoryActivities.
+const (
+	SubcategoryEvent         Subcategory = "event"
+	SubcategoryAwardMedal    Subcategory = "award-medal"
+	SubcategorySport         Subcategory = "sport"
+	SubcategoryGame          Subcategory = "game"
+	SubcategoryArtsAndCrafts Subcategory = "arts & crafts"
+)
+
+// Subcategories of CategoryObjects.
+const (
+	SubcategoryClothing          Subcategory = "clothing"
+	SubcategorySound             Subcategory = "sound"
+	SubcategoryMusic             Subcategory = "music"
+	SubcategoryMusicalInstrument Subcategory = "musical-instrument"
+	SubcategoryPhone             Subcategory = "phone"
+	SubcategoryComputer          Subcategory = "computer"
+	SubcategoryLightAndVideo     Subcategory = "light & video"
+	SubcategoryBookPaper         Subcategory = "book-paper"
+	SubcategoryMoney             Subcategory = "money"
+	SubcategoryMail              Subcategory = "mail"
+	SubcategoryWriting           Subcategory = "writing"
+	SubcategoryOffice            Subcategory = "office"
+	SubcategoryLock              Subcategory = "lock"
+	SubcategoryTool              Subcategory = "tool"
+	SubcategoryScience           Subcategory = "science"
+	SubcategoryMedical           Subcategory = "medical"
+	SubcategoryHousehold         Subcategory = "household"
+	SubcategoryOtherObject       Subcategory = "other-object"
+)
+
+// Subcategories of CategorySymbols.
+const (
+	SubcategoryTransportSign Subcategory = "transport-sign"
+	SubcategoryWarning       Subcategory = "warning"
+	SubcategoryArrow         Subcategory = "arrow"
+	SubcategoryReligion      Subcategory = "religion"
+	SubcategoryZodiac        Subcategory = "zodiac"
+	SubcategoryAVSymbol      Subcategory = "av-symbol"
+	SubcategoryGender        Subcategory = "gender"
+	SubcategoryMath          Subcategory = "math"
+	SubcategoryPunctuation   Subcategory = "punctuation"
+	SubcategoryCurrency      Subcategory = "currency"
+	SubcategoryOtherSymbol   Subcategory = "other-symbol"
+	SubcategoryKeycap        Subcategory = "keycap"
+	SubcategoryAlphanum      Subcategory = "alphanum"
+	SubcategoryGeometric     Subcategory = "geometric"
+)
+
+// Subcategories of CategoryFlags.
+const (
+	SubcategoryFlag            Subcategory = "flag"
+	SubcategoryCountryFlag     Subcategory = "country-flag"
+	SubcategorySubdivisionFlag Subcategory = "subdivision-flag"
+)
+
+// subcategories contains the subcategories of every category in the official emoji order.
+var subcategories = map[Category][]Subcategory{
+	CategorySmileysAndEmotion: {
+		SubcategoryFaceSmiling,
+		SubcategoryFaceAffection,
+		SubcategoryFaceTongue,
+		SubcategoryFaceHand,
+		SubcategoryFaceNeutralSkeptical,
+		SubcategoryFaceSleepy,
+		SubcategoryFaceUnwell,
+		SubcategoryFaceHat,
+		SubcategoryFaceGlasses,
+		SubcategoryFaceConcerned,
+		SubcategoryFaceNegative,
+		SubcategoryFaceCostume,
+		SubcategoryCatFace,
+		SubcategoryMonkeyFace,
+		SubcategoryHeart,
+		SubcategoryEmotion,
+	},
+	CategoryPeopleAndBody: {
+		SubcategoryHandFingersOpen,
+		SubcategoryHandFingersPartial,
+		SubcategoryHandSingleFinger,
+		SubcategoryHandFingersClosed,
+		SubcategoryHands,
+		SubcategoryHandProp,
+		SubcategoryBodyParts,
+		SubcategoryPerson,
+		SubcategoryPersonGesture,
+		SubcategoryPersonRole,
+		SubcategoryPersonFantasy,
+		SubcategoryPersonActivity,
+		SubcategoryPersonSport,
+		SubcategoryPersonResting,
+		SubcategoryFamily,
+		SubcategoryPersonSymbol,
+	},
+	CategoryComponent: {
+		SubcategorySkinTone,
+	},
+	CategoryAnimalsAndNature: {
+		SubcategoryAnimalMammal,
+		SubcategoryAnimalBird,
+		SubcategoryAnimalAmphibian,
+		SubcategoryAnimalReptile,
+		SubcategoryAnimalMarine,
+		SubcategoryAnimalBug,
+		SubcategoryPlantFlower,
+		SubcategoryPlantOther,
+	},
+	CategoryFoodAndDrink: {
+		SubcategoryFoodFruit,
+		SubcategoryFoodVegetable,
+		SubcategoryFoodPrepared,
+		SubcategoryFoodAsian,
+		SubcategoryFoodMarine,
+		SubcategoryFoodSweet,
+		SubcategoryDrink,
+		SubcategoryDishware,
+	},
+	CategoryTravelAndPlaces: {
+		SubcategoryPlaceMap,
+		SubcategoryPlaceGeographic,
+		SubcategoryPlaceBuilding,
+		SubcategoryPlaceReligious,
+		SubcategoryPlaceOther,
+		SubcategoryTransportGround,
+		SubcategoryTransportWater,
+		SubcategoryTransportAir,
+		SubcategoryHotel,
+		SubcategoryTime,
+		SubcategorySkyAndWeather,
+	},
+	CategoryActivities: {
+		SubcategoryEvent,
+		SubcategoryAwardMedal,
+		SubcategorySport,
+		SubcategoryGame,
+		SubcategoryArtsAndCrafts,
+	},
+	CategoryObjects: {
+		SubcategoryClothing,
+		SubcategorySound,
+		SubcategoryMusic,
+		SubcategoryMusicalInstrument,
+		SubcategoryPhone,
+		SubcategoryComputer,
+		SubcategoryLightAndVideo,
+		SubcategoryBookPaper,
+		SubcategoryMoney,
+		SubcategoryMail,
+		SubcategoryWriting,
+		SubcategoryOffice,
+		SubcategoryLock,
+		SubcategoryTool,
+		SubcategoryScience,
+		SubcategoryMedical,
+		SubcategoryHousehold,
+		SubcategoryOtherObject,
+	},
+	CategorySymbols: {
+		SubcategoryTransportSign,
+		SubcategoryWarning,
+		SubcategoryArrow,
+		SubcategoryReligion,
+		SubcategoryZodiac,
+		SubcategoryAVSymbol,
+		SubcategoryGender,
+		SubcategoryMath,
+		SubcategoryPunctuation,
+		SubcategoryCurrency,
+		SubcategoryOtherSymbol,
+		SubcategoryKeycap,
+		SubcategoryAlphanum,
+		SubcategoryGeometric,
+	},
+	CategoryFlags: {
+		SubcategoryFlag,
+		SubcategoryCountryFlag,
+		SubcategorySubdivisionFlag,
+	},
+}
+
+// Categories returns all categories in the official emoji order.
+func Categories() []Category {
+	return []Category{
+		CategorySmileysAndEmotion,
+		CategoryPeopleAndBody,
+		CategoryComponent,
+		CategoryAnimalsAndNature,
+		CategoryFoodAndDrink,
+		CategoryTravelAndPlaces,
+		CategoryActivities,
+		CategoryObjects,
+		CategorySymbols,
+		CategoryFlags,
+	}
+}
+
+// InCategory returns the emojis in the category in the official emoji order.
+func InCategory(c Category) []Info {
+	return filterSorted(func(info Info) bool {
+		return info.Category == c
+	})
+}
+
+// InSubcategory returns the emojis in the subcategory in the official emoji order.
+func InSubcategory(s Subcategory) []Info {
+	return filterSorted(func(info Info) bool {
+		return info.Subcategory == s
+	})
+}
+
+// Subcategories returns all subcategories in the official emoji order.
+func Subcategories() []Subcategory {
+	var result []Subcategory
+	for _, c := range Categories() {
+		result = append(result, subcategories[c]...)
+	}
+	return result
+}
+
+// Subcategories returns the subcategories of the category in the official emoji order.
+func (c Category) Subcategories() []Subcategory {
+	return append([]Subcategory(nil), subcategories[c]...)
+}
+
+// filterSorted returns the emojis that match the predicate ordered by SortOrder.
+func filterSorted(predicate func(Info) bool) []Info {
+	var results []Info
+	for _, info := range All() {
+		if predicate(info) {
+			results = append(results, info)
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].SortOrder < results[j].SortOrder
+	})
+	return results
+}
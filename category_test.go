@@ -0,0 +1,82 @@
+package emoji
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCategories(t *testing.T) {
+	categories := map[Category]bool{}
+	for _, c := range Categories() {
+		categories[c] = true
+	}
+	for _, info := range All() {
+		if !categories[info.Category] {
+			t.Errorf("emoji %s has unknown category %q", info.Name, info.Category)
+		}
+	}
+}
+
+func TestInCategory(t *testing.T) {
+	infos := InCategory(CategoryComponent)
+	var names []string
+	for _, info := range infos {
+		names = append(names, info.Name)
+	}
+	want := []string{"skin_tone_2", "skin_tone_3", "skin_tone_4", "skin_tone_5", "skin_tone_6"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("InCategory() = %v, want %v", names, want)
+	}
+}
+
+func TestInCategory_SortOrder(t *testing.T) {
+	infos := InCategory(CategorySmileysAndEmotion)
+	if len(infos) < 3 {
+		t.Fatalf("InCategory() returned %d emojis", len(infos))
+	}
+	var names []string
+	for _, info := range infos[:3] {
+		names = append(names, info.Name)
+	}
+	if want := []string{"grinning", "smiley", "smile"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("InCategory() starts with %v, want %v", names, want)
+	}
+	for i := 1; i < len(infos); i++ {
+		if infos[i-1].SortOrder >= infos[i].SortOrder {
+			t.Errorf("%s has sort order %d after %s with %d", infos[i].Name, infos[i].SortOrder, infos[i-1].Name, infos[i-1].SortOrder)
+		}
+	}
+}
+
+func TestSubcategories(t *testing.T) {
+	categories := map[Subcategory]Category{}
+	for _, c := range Categories() {
+		for _, s := range c.Subcategories() {
+			categories[s] = c
+		}
+	}
+	if got := len(Subcategories()); got != len(categories) {
+		t.Errorf("Subcategories() returned %d subcategories, want %d", got, len(categories))
+	}
+	for _, info := range All() {
+		if c, ok := categories[info.Subcategory]; !ok || c != info.Category {
+			t.Errorf("emoji %s has subcategory %q outside of category %q", info.Name, info.Subcategory, info.Category)
+		}
+	}
+	if got := CategoryFlags.Subcategories(); !reflect.DeepEqual(got, []Subcategory{SubcategoryFlag, SubcategoryCountryFlag, SubcategorySubdivisionFlag}) {
+		t.Errorf("Subcategories() = %v", got)
+	}
+}
+
+func TestInSubcategory(t *testing.T) {
+	var names []string
+	for _, info := range InSubcategory(SubcategoryFaceSmiling)[:3] {
+		names = append(names, info.Name)
+	}
+	if want := []string{"grinning", "smiley", "smile"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("InSubcategory() starts with %v, want %v", names, want)
+	}
+	if got := InSubcategory("fubar"); got != nil {
+		t.Errorf("InSubcategory() = %v, want nil", got)
+	}
+}
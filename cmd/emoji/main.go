@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"text/tabwriter"
@@ -26,18 +27,32 @@ func main() {
 	)
 
 	root := &cobra.Command{
-		Use:     "emoji [-l limit] [-d maxdistance] [-f char|text|json] [-s skin] query",
-		Short:   "Look up emojis with a keyword",
-		Example: "emoji -l 1 rocket",
+		Use:   "emoji [-l limit] [-d maxdistance] [-f char|text|json] [-s skin] query",
+		Short: "Look up emojis with a query",
+		Long: `Look up emojis with a query of keywords that must all match.
+
+A keyword starting with "-" excludes matching emojis, and the qualifiers
+category:, subcategory:, version: and platform: filter the emojis,
+e.g. 'version:<13' or -platform:facebook. Flags must come before the query,
+every argument after the first keyword is part of the query.`,
+		Example: "emoji -l 1 rocket\nemoji face -cat\nemoji heart category:symbols 'version:<13' platform:twitter -broken",
 		Args:    cobra.MinimumNArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
 			cmd.SetOut(os.Stdout)
-			query := strings.Join(args, " ")
+			query, err := queryArgs(cmd, args)
+			if err != nil {
+				cmd.PrintErrf("invalid query: %v", err)
+				os.Exit(1)
+			}
 			searcher := emoji.NewSearchIndex(
 				emoji.WithLimit(searchOptLimit),
 				emoji.WithMaxDistance(searchOptMaxDistance))
 
-			results := searcher.Search(query)
+			results, err := searcher.Query(query)
+			if err != nil {
+				cmd.PrintErrf("invalid query: %v", err)
+				os.Exit(1)
+			}
 			formatter, ok := formatters[outputFormat]
 			if !ok {
 				cmd.PrintErrf("unsupported output format \"%s\"", outputFormat)
@@ -61,6 +76,47 @@ func main() {
 		},
 	}
 
+	convert := func(conversion emoji.Conversion) func(cmd *cobra.Command, args []string) {
+		return func(cmd *cobra.Command, args []string) {
+			modifier, err := emoji.NewModifier(skinTone)
+			if err != nil {
+				cmd.PrintErrf("unsupported skin tone %s: %v", skinTone, err)
+				os.Exit(1)
+			}
+			emojizer := emoji.NewEmojizer(emoji.WithSkinTone(modifier))
+			w := emojizer.NewWriter(os.Stdout, conversion)
+			if _, err := io.Copy(w, os.Stdin); err != nil {
+				cmd.PrintErrf("failed converting input: %v", err)
+				os.Exit(1)
+			}
+			if err := w.Close(); err != nil {
+				cmd.PrintErrf("failed writing output: %v", err)
+				os.Exit(1)
+			}
+		}
+	}
+	root.AddCommand(
+		&cobra.Command{
+			Use:     "emojize",
+			Short:   "Replace shortcodes read from stdin with emojis",
+			Example: "echo ':rocket: launch' | emoji emojize",
+			Args:    cobra.NoArgs,
+			Run:     convert(emoji.ToEmoji),
+		},
+		&cobra.Command{
+			Use:     "demojize",
+			Short:   "Replace emojis read from stdin with shortcodes",
+			Example: "echo '🚀 launch' | emoji demojize",
+			Args:    cobra.NoArgs,
+			Run:     convert(emoji.ToShortcode),
+		},
+	)
+
+	// negated keywords such as -cat are not parsed as flags after the first keyword
+	root.Flags().SetInterspersed(false)
+	root.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		return fmt.Errorf("%w\nnegated keywords must follow a keyword, e.g. emoji face -cat", err)
+	})
 	root.PersistentFlags().IntVarP(
 		&searchOptLimit,
 		"limit",
@@ -78,7 +134,7 @@ func main() {
 		"skin",
 		"s",
 		"",
-		"skin tone [light|medium_light|medium|medium_dark|dark]")
+		"skin tone [light|medium_light|medium|medium_dark|dark], or one per person separated by commas")
 	root.PersistentFlags().StringVarP(
 		&outputFormat,
 		"format",
@@ -92,6 +148,28 @@ func main() {
 	}
 }
 
+// queryArgs joins the arguments to a query. Flags of the command are rejected,
+// because they are not parsed after the first keyword and would silently
+// become negated keywords.
+func queryArgs(cmd *cobra.Command, args []string) (string, error) {
+	terms := make([]string, 0, len(args))
+	for _, arg := range args {
+		if arg == "--" {
+			continue
+		}
+		if strings.HasPrefix(arg, "--") {
+			name := strings.SplitN(arg[2:], "=", 2)[0]
+			if cmd.Flags().Lookup(name) != nil {
+				return "", fmt.Errorf("flag %s must come before the query", arg)
+			}
+		} else if len(arg) == 2 && arg[0] == '-' && cmd.Flags().ShorthandLookup(arg[1:]) != nil {
+			return "", fmt.Errorf("flag %s must come before the query", arg)
+		}
+		terms = append(terms, arg)
+	}
+	return strings.Join(terms, " "), nil
+}
+
 func charFormatter(results []emoji.Info, skinTone emoji.Modifier) (string, error) {
 	sb := strings.Builder{}
 	sb.Grow(4 * len(results))
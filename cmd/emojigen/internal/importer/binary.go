@@ -0,0 +1,151 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"sort"
+)
+
+// datasetMagic identifies the binary dataset and its format version.
+const datasetMagic = "EMOJI\x01"
+
+// Platform bits of the binary dataset.
+const (
+	platformApple = 1 << iota
+	platformGoogle
+	platformTwitter
+	platformFacebook
+)
+
+// WriteDataset writes the emojis to the given io.Writer in the binary format
+// that the emoji package embeds and decodes on first use.
+//
+// The dataset starts with datasetMagic and a table of all distinct strings,
+// followed by the emojis. Numbers are unsigned varints and strings are
+// indexes into the string table, whose first entry is the empty string:
+//
+//	dataset  = magic count(strings) len(string)... bytes count(emojis) emoji...
+//	emoji    = name fullName category subcategory sortOrder plainText
+//	           count(texts) text... count(names) name... count(keywords) keyword...
+//	           image count(variations) (modifier image)...
+//	image    = unified nonQualified character image sheetX sheetY addedIn
+//	           platforms obsoletes obsoletedBy
+//
+// Modifiers are skin variation keys such as "1F3FB-1F3FF" and platforms
+// is a bitmask of platformApple, platformGoogle, platformTwitter and platformFacebook.
+func WriteDataset(w io.Writer, emojis []EmojiInfo) error {
+	table := newStringTable()
+	records := &bytes.Buffer{}
+	putUint := func(v int) {
+		writeUvarint(records, v)
+	}
+	putString := func(s string) {
+		putUint(table.index(s))
+	}
+	putStrings := func(values []string) {
+		putUint(len(values))
+		for _, s := range values {
+			putString(s)
+		}
+	}
+	putImage := func(data EmojiImageData) {
+		putString(data.Unified)
+		putString(data.NonQualified)
+		putString(data.Character)
+		putString(data.Image)
+		putUint(data.SheetX)
+		putUint(data.SheetY)
+		putString(data.AddedIn)
+		putUint(platformBits(data))
+		putString(data.Obsoletes)
+		putString(data.ObsoletedBy)
+	}
+
+	putUint(len(emojis))
+	for _, emoji := range emojis {
+		putString(emoji.ShortName)
+		putString(emoji.Name)
+		putString(emoji.Category)
+		putString(emoji.Subcategory)
+		putUint(emoji.SortOrder)
+		putString(emoji.Text)
+		putStrings(emoji.Texts)
+		putStrings(emoji.ShortNames)
+		putStrings(emoji.Keywords)
+		putImage(emoji.EmojiImageData)
+		modifiers := make([]string, 0, len(emoji.SkinVariations))
+		for modifier := range emoji.SkinVariations {
+			modifiers = append(modifiers, modifier)
+		}
+		sort.Strings(modifiers)
+		putUint(len(modifiers))
+		for _, modifier := range modifiers {
+			putString(modifier)
+			putImage(emoji.SkinVariations[modifier])
+		}
+	}
+
+	header := bytes.NewBufferString(datasetMagic)
+	writeUvarint(header, len(table.values))
+	for _, s := range table.values {
+		writeUvarint(header, len(s))
+	}
+	for _, s := range table.values {
+		header.WriteString(s)
+	}
+	if _, err := header.WriteTo(w); err != nil {
+		return err
+	}
+	_, err := records.WriteTo(w)
+	return err
+}
+
+// writeUvarint writes the number as an unsigned varint.
+func writeUvarint(buf *bytes.Buffer, v int) {
+	var scratch [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(scratch[:], uint64(v))
+	buf.Write(scratch[:n])
+}
+
+// stringTable assigns indexes to distinct strings in order of first use.
+type stringTable struct {
+	indexes map[string]int
+	values  []string
+}
+
+func newStringTable() *stringTable {
+	return &stringTable{
+		indexes: map[string]int{"": 0},
+		values:  []string{""},
+	}
+}
+
+// index returns the index of the string, adding it to the table if necessary.
+func (t *stringTable) index(s string) int {
+	if idx, ok := t.indexes[s]; ok {
+		return idx
+	}
+	idx := len(t.values)
+	t.indexes[s] = idx
+	t.values = append(t.values, s)
+	return idx
+}
+
+// platformBits returns the platform bitmask of the image in the binary dataset.
+func platformBits(data EmojiImageData) int {
+	var bits int
+	if data.HasImgApple {
+		bits |= platformApple
+	}
+	if data.HasImgGoogle {
+		bits |= platformGoogle
+	}
+	if data.HasImgTwitter {
+		bits |= platformTwitter
+	}
+	if data.HasImgFacebook {
+		bits |= platformFacebook
+	}
+	return bits
+}
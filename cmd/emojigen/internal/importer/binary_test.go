@@ -0,0 +1,33 @@
+package importer
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWriteDataset(t *testing.T) {
+	emojis := []EmojiInfo{{
+		ShortName:      "a",
+		Name:           "b",
+		Category:       "c",
+		ShortNames:     []string{"a"},
+		EmojiImageData: EmojiImageData{Unified: "1", HasImgApple: true, HasImgFacebook: true},
+		SkinVariations: map[string]EmojiImageData{"1F3FB": {Unified: "2"}},
+	}}
+	var buf bytes.Buffer
+	if err := WriteDataset(&buf, emojis); err != nil {
+		t.Fatalf("WriteDataset() error = %v", err)
+	}
+	want := []byte(datasetMagic)
+	// string table: "", "a", "b", "c", "1", "1F3FB", "2"
+	want = append(want, 7, 0, 1, 1, 1, 1, 5, 1)
+	want = append(want, "abc11F3FB2"...)
+	// emoji
+	want = append(want, 1, 1, 2, 3, 0, 0, 0, 0, 1, 1, 0)
+	want = append(want, 4, 0, 0, 0, 0, 0, 0, platformApple|platformFacebook, 0, 0)
+	// skin variation
+	want = append(want, 1, 5, 6, 0, 0, 0, 0, 0, 0, 0, 0, 0)
+	if got := buf.Bytes(); !bytes.Equal(got, want) {
+		t.Errorf("WriteDataset() = %v, want %v", got, want)
+	}
+}
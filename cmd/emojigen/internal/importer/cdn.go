@@ -11,8 +11,9 @@ import (
 
 // CDN provides an interface for a remote emoji dataset.
 type CDN struct {
-	url        url.URL
-	httpClient *http.Client
+	url            url.URL
+	annotationsURL url.URL
+	httpClient     *http.Client
 }
 
 // NewCDN creates a new CDN client for emoji data.
@@ -21,14 +22,22 @@ func NewCDN() (*CDN, error) {
 	if err != nil {
 		return nil, fmt.Errorf("invalid CDN url: %w", err)
 	}
+	annotationsURL, err := url.Parse("https://cdn.jsdelivr.net/npm/cldr-annotations-full@44")
+	if err != nil {
+		return nil, fmt.Errorf("invalid CDN url: %w", err)
+	}
 	return &CDN{
-		url:        *u,
-		httpClient: http.DefaultClient,
+		url:            *u,
+		annotationsURL: *annotationsURL,
+		httpClient:     http.DefaultClient,
 	}, nil
 }
 
 func (c *CDN) get(ctx context.Context, pathComponents ...string) (*http.Response, error) {
-	u := c.url
+	return c.getURL(ctx, c.url, pathComponents...)
+}
+
+func (c *CDN) getURL(ctx context.Context, u url.URL, pathComponents ...string) (*http.Response, error) {
 	u.Path = path.Join(append([]string{u.Path}, pathComponents...)...)
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), http.NoBody)
 	if err != nil {
@@ -50,6 +59,19 @@ func (c *CDN) DownloadEmojiInfo(ctx context.Context) ([]EmojiInfo, error) {
 	return ParseEmojiData(resp.Body)
 }
 
+// DownloadAnnotations retrieves and decodes the CLDR annotations of a locale, e.g. "en", from the CDN.
+func (c *CDN) DownloadAnnotations(ctx context.Context, locale string) (Annotations, error) {
+	resp, err := c.getURL(ctx, c.annotationsURL, "annotations", locale, "annotations.json")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed fetching %s annotations with status code %d", locale, resp.StatusCode)
+	}
+	return ParseAnnotations(resp.Body)
+}
+
 // DownloadSpriteSheet downloads the sprite sheet from the CDN.
 func (c *CDN) DownloadSpriteSheet(ctx context.Context, width int) (*SpriteSheet, error) {
 	switch width {
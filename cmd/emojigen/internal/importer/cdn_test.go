@@ -0,0 +1,38 @@
+package importer
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestCDN_DownloadAnnotations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cldr/annotations/en/annotations.json" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(`{"annotations": {"annotations": {"🚀": {"default": ["rocket", "space"], "tts": ["rocket"]}}}}`))
+	}))
+	defer server.Close()
+	cdn, err := NewCDN()
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, _ := url.Parse(server.URL + "/cldr")
+	cdn.annotationsURL = *u
+
+	annotations, err := cdn.DownloadAnnotations(context.Background(), "en")
+	if err != nil {
+		t.Fatalf("DownloadAnnotations() error = %v", err)
+	}
+	if want := (Annotations{"🚀": {Name: "rocket", Keywords: []string{"rocket", "space"}}}); !reflect.DeepEqual(annotations, want) {
+		t.Errorf("DownloadAnnotations() = %v, want %v", annotations, want)
+	}
+	if _, err := cdn.DownloadAnnotations(context.Background(), "xx"); err == nil {
+		t.Error("DownloadAnnotations() expected error for a missing locale")
+	}
+}
@@ -0,0 +1,195 @@
+package importer
+
+import (
+	"bufio"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Annotation contains the CLDR annotations of an emoji for a single locale.
+type Annotation struct {
+	// Name is the text-to-speech name of the emoji.
+	Name string
+	// Keywords are words that describe the emoji.
+	Keywords []string
+}
+
+// Annotations maps emoji characters without emoji presentation selectors
+// to their annotations.
+type Annotations map[string]Annotation
+
+// LoadAnnotations reads a CLDR annotations file in either the LDML XML format,
+// e.g. common/annotations/en.xml, or the cldr-json format,
+// e.g. cldr-annotations-full/annotations/en/annotations.json.
+func LoadAnnotations(path string) (Annotations, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed opening annotations %s: %w", path, err)
+	}
+	defer f.Close()
+	annotations, err := ParseAnnotations(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing annotations %s: %w", path, err)
+	}
+	return annotations, nil
+}
+
+// ParseAnnotations parses CLDR annotations in either the LDML XML
+// or the cldr-json format.
+func ParseAnnotations(r io.Reader) (Annotations, error) {
+	br := bufio.NewReader(r)
+	for {
+		b, err := br.Peek(1)
+		if err != nil {
+			return nil, fmt.Errorf("failed detecting annotations format: %w", err)
+		}
+		switch b[0] {
+		case ' ', '\t', '\r', '\n':
+			_, _ = br.ReadByte()
+			continue
+		case '{':
+			return parseJSONAnnotations(br)
+		default:
+			return parseXMLAnnotations(br)
+		}
+	}
+}
+
+func parseXMLAnnotations(r io.Reader) (Annotations, error) {
+	var document struct {
+		Annotations []struct {
+			Character string `xml:"cp,attr"`
+			Type      string `xml:"type,attr"`
+			Text      string `xml:",chardata"`
+		} `xml:"annotations>annotation"`
+	}
+	if err := xml.NewDecoder(r).Decode(&document); err != nil {
+		return nil, err
+	}
+	annotations := Annotations{}
+	for _, element := range document.Annotations {
+		key := annotationKey(element.Character)
+		annotation := annotations[key]
+		if element.Type == "tts" {
+			annotation.Name = strings.TrimSpace(element.Text)
+		} else {
+			annotation.Keywords = splitKeywords(element.Text)
+		}
+		annotations[key] = annotation
+	}
+	return annotations, nil
+}
+
+func parseJSONAnnotations(r io.Reader) (Annotations, error) {
+	var document struct {
+		Annotations struct {
+			Annotations map[string]struct {
+				Default []string `json:"default"`
+				TTS     []string `json:"tts"`
+			} `json:"annotations"`
+		} `json:"annotations"`
+	}
+	if err := json.NewDecoder(r).Decode(&document); err != nil {
+		return nil, err
+	}
+	annotations := Annotations{}
+	for character, element := range document.Annotations.Annotations {
+		annotation := Annotation{Keywords: element.Default}
+		if len(element.TTS) > 0 {
+			annotation.Name = element.TTS[0]
+		}
+		annotations[annotationKey(character)] = annotation
+	}
+	return annotations, nil
+}
+
+// splitKeywords splits the pipe separated keywords of an XML annotation.
+func splitKeywords(text string) (keywords []string) {
+	for _, keyword := range strings.Split(text, "|") {
+		if keyword = strings.TrimSpace(keyword); len(keyword) > 0 {
+			keywords = append(keywords, keyword)
+		}
+	}
+	return keywords
+}
+
+// annotationKey removes emoji presentation selectors, which CLDR omits.
+func annotationKey(character string) string {
+	return strings.ReplaceAll(character, "\ufe0f", "")
+}
+
+// Get returns the annotation for an emoji character.
+func (a Annotations) Get(character string) (Annotation, bool) {
+	annotation, ok := a[annotationKey(character)]
+	return annotation, ok
+}
+
+// ApplyKeywords sets the keywords of every emoji from its annotation.
+// Keywords that repeat a name of the emoji are omitted.
+func ApplyKeywords(emojis []EmojiInfo, annotations Annotations) {
+	for i := range emojis {
+		annotation, ok := annotations.Get(emojis[i].Character)
+		if !ok {
+			continue
+		}
+		emojis[i].Keywords = annotationKeywords(emojis[i], annotation)
+	}
+}
+
+func annotationKeywords(e EmojiInfo, annotation Annotation) (keywords []string) {
+	added := map[string]struct{}{
+		strings.ToLower(e.Name):      {},
+		strings.ToLower(e.ShortName): {},
+	}
+	for _, name := range e.ShortNames {
+		added[strings.ToLower(name)] = struct{}{}
+	}
+	for _, keyword := range annotation.Keywords {
+		keyword = strings.ToLower(keyword)
+		if _, exists := added[keyword]; exists {
+			continue
+		}
+		keywords = append(keywords, keyword)
+		added[keyword] = struct{}{}
+	}
+	return keywords
+}
+
+// LocalizedEmoji contains the localized name and keywords of an emoji.
+type LocalizedEmoji struct {
+	Unified  string
+	Name     string
+	Keywords []string
+}
+
+// Localize returns the localized names and keywords of the emojis that have an annotation.
+// Keywords that repeat the localized name are omitted.
+func Localize(emojis []EmojiInfo, annotations Annotations) []LocalizedEmoji {
+	var localized []LocalizedEmoji
+	for _, e := range emojis {
+		annotation, ok := annotations.Get(e.Character)
+		if !ok || len(annotation.Name) == 0 {
+			continue
+		}
+		var keywords []string
+		added := map[string]struct{}{strings.ToLower(annotation.Name): {}}
+		for _, keyword := range annotation.Keywords {
+			keyword = strings.ToLower(keyword)
+			if _, exists := added[keyword]; exists {
+				continue
+			}
+			keywords = append(keywords, keyword)
+			added[keyword] = struct{}{}
+		}
+		localized = append(localized, LocalizedEmoji{
+			Unified:  e.Unified,
+			Name:     annotation.Name,
+			Keywords: keywords,
+		})
+	}
+	return localized
+}
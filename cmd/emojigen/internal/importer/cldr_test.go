@@ -0,0 +1,91 @@
+package importer
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseAnnotations(t *testing.T) {
+	want := Annotations{
+		"❤": {Name: "red heart", Keywords: []string{"heart", "love"}},
+		"🚀": {Name: "rocket", Keywords: []string{"rocket", "space"}},
+	}
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name: "xml",
+			input: `<?xml version="1.0" encoding="UTF-8" ?>
+<ldml>
+	<annotations>
+		<annotation cp="❤️">heart | love</annotation>
+		<annotation cp="❤️" type="tts">red heart</annotation>
+		<annotation cp="🚀">rocket | space</annotation>
+		<annotation cp="🚀" type="tts">rocket</annotation>
+	</annotations>
+</ldml>`,
+		},
+		{
+			name: "json",
+			input: `
+{
+	"annotations": {
+		"annotations": {
+			"❤": {"default": ["heart", "love"], "tts": ["red heart"]},
+			"🚀": {"default": ["rocket", "space"], "tts": ["rocket"]}
+		}
+	}
+}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAnnotations(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("ParseAnnotations() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("ParseAnnotations() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestApplyKeywords(t *testing.T) {
+	emojis := []EmojiInfo{
+		{Name: "ROCKET", ShortName: "rocket", ShortNames: []string{"rocket"}, EmojiImageData: EmojiImageData{Character: "🚀"}},
+		{Name: "HEAVY BLACK HEART", ShortName: "heart", ShortNames: []string{"heart"}, EmojiImageData: EmojiImageData{Character: "❤️"}},
+		{Name: "WAVING HAND SIGN", ShortName: "wave", ShortNames: []string{"wave"}, EmojiImageData: EmojiImageData{Character: "👋"}},
+	}
+	annotations := Annotations{
+		"🚀": {Keywords: []string{"rocket", "Space", "space"}},
+		"❤": {Keywords: []string{"heart", "love"}},
+	}
+	ApplyKeywords(emojis, annotations)
+
+	want := [][]string{{"space"}, {"love"}, nil}
+	for i, e := range emojis {
+		if !reflect.DeepEqual(e.Keywords, want[i]) {
+			t.Errorf("ApplyKeywords() %s keywords = %v, want %v", e.ShortName, e.Keywords, want[i])
+		}
+	}
+}
+
+func TestLocalize(t *testing.T) {
+	emojis := []EmojiInfo{
+		{ShortName: "rocket", EmojiImageData: EmojiImageData{Unified: "1f680", Character: "🚀"}},
+		{ShortName: "wave", EmojiImageData: EmojiImageData{Unified: "1f44b", Character: "👋"}},
+	}
+	annotations := Annotations{
+		"🚀": {Name: "Rakete", Keywords: []string{"Rakete", "Weltraum"}},
+		"👋": {Keywords: []string{"winken"}},
+	}
+	want := []LocalizedEmoji{
+		{Unified: "1f680", Name: "Rakete", Keywords: []string{"weltraum"}},
+	}
+	if got := Localize(emojis, annotations); !reflect.DeepEqual(got, want) {
+		t.Errorf("Localize() = %v, want %v", got, want)
+	}
+}
@@ -44,3 +44,16 @@ func uniqueKeywords(e EmojiInfo) (keywords []string) {
 	}
 	return keywords
 }
+
+// uniqueTexts returns the text representations without duplicates.
+func uniqueTexts(e EmojiInfo) (texts []string) {
+	added := map[string]struct{}{}
+	for _, text := range e.Texts {
+		if _, exists := added[text]; exists || len(text) == 0 {
+			continue
+		}
+		texts = append(texts, text)
+		added[text] = struct{}{}
+	}
+	return texts
+}
@@ -78,3 +78,34 @@ func Test_sanitizedName(t *testing.T) {
 		})
 	}
 }
+
+func Test_uniqueTexts(t *testing.T) {
+	tests := []struct {
+		name  string
+		input EmojiInfo
+		want  []string
+	}{
+		{
+			"duplicate texts",
+			EmojiInfo{Text: ":)", Texts: []string{"=)", ":)", "=)", ""}},
+			[]string{"=)", ":)"},
+		},
+		{
+			"texts without canonical text",
+			EmojiInfo{Texts: []string{"C:", "c:"}},
+			[]string{"C:", "c:"},
+		},
+		{
+			"no texts",
+			EmojiInfo{},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := uniqueTexts(tt.input); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("uniqueTexts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -38,14 +38,11 @@ func ParseEmojiData(r io.Reader) ([]EmojiInfo, error) {
 			hasName[keyword] = true
 		}
 		info.ShortNames = alternateNames
+		info.Texts = uniqueTexts(info)
 
 		mutatedVariations := map[string]EmojiImageData{}
 		for modifier, variation := range info.SkinVariations {
-			switch modifier {
-			case "1F3FB", "1F3FC", "1F3FD", "1F3FE", "1F3FF":
-				break
-			default:
-				// TODO support multi-skin-tone emojis
+			if !isSkinToneSequence(modifier) {
 				continue
 			}
 			variationChr, err := loadUnifiedSequence(variation.Unified)
@@ -63,13 +60,16 @@ func ParseEmojiData(r io.Reader) ([]EmojiInfo, error) {
 
 // EmojiInfo captures standard emoji attributes for a named and tagged emoji.
 type EmojiInfo struct {
-	Name       string   `json:"name"`
-	ShortName  string   `json:"short_name"`
-	ShortNames []string `json:"short_names"`
-	Text       string   `json:"text"`
-	Texts      []string `json:"texts"`
-	Category   string   `json:"category"`
-	SortOrder  int      `json:"sort_order"`
+	Name        string   `json:"name"`
+	ShortName   string   `json:"short_name"`
+	ShortNames  []string `json:"short_names"`
+	Text        string   `json:"text"`
+	Texts       []string `json:"texts"`
+	Category    string   `json:"category"`
+	Subcategory string   `json:"subcategory"`
+	SortOrder   int      `json:"sort_order"`
+	// Keywords are not present in the original input but populated from CLDR annotations.
+	Keywords []string `json:"keywords,omitempty"`
 	EmojiImageData
 	SkinVariations map[string]EmojiImageData `json:"skin_variations"`
 }
@@ -105,6 +105,20 @@ type EmojiImageData struct {
 	ObsoletedBy    string `json:"obsoleted_by"`
 }
 
+// isSkinToneSequence reports whether a skin variation key consists of one
+// skin tone per person, e.g. "1F3FB" or "1F3FB-1F3FF".
+func isSkinToneSequence(modifier string) bool {
+	for _, tone := range strings.Split(modifier, "-") {
+		switch tone {
+		case "1F3FB", "1F3FC", "1F3FD", "1F3FE", "1F3FF":
+			continue
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 // loadUnifiedSequence returns an emoji unicode string from a unified hex sequence.
 //
 // A sequence is hyphen separated sequence of hex-encoded UTF8 codepoints.
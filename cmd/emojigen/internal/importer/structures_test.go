@@ -0,0 +1,75 @@
+package importer
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestParseEmojiData(t *testing.T) {
+	input := `[{
+		"name": "PEOPLE HOLDING HANDS",
+		"short_name": "people_holding_hands",
+		"short_names": ["people_holding_hands"],
+		"unified": "1F9D1-200D-1F91D-200D-1F9D1",
+		"skin_variations": {
+			"1F3FB-1F3FB": {"unified": "1F9D1-1F3FB-200D-1F91D-200D-1F9D1-1F3FB"},
+			"1F3FB-1F3FF": {"unified": "1F9D1-1F3FB-200D-1F91D-200D-1F9D1-1F3FF"},
+			"1F3FB-XXXXX": {"unified": "1F9D1"}
+		}
+	}]`
+	emojis, err := ParseEmojiData(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseEmojiData() error = %v", err)
+	}
+	if len(emojis) != 1 {
+		t.Fatalf("ParseEmojiData() returned %d emojis, want 1", len(emojis))
+	}
+	var modifiers []string
+	for modifier := range emojis[0].SkinVariations {
+		modifiers = append(modifiers, modifier)
+	}
+	sort.Strings(modifiers)
+	if want := []string{"1F3FB-1F3FB", "1F3FB-1F3FF"}; !reflect.DeepEqual(modifiers, want) {
+		t.Errorf("ParseEmojiData() skin variations = %v, want %v", modifiers, want)
+	}
+	if got, want := emojis[0].SkinVariations["1F3FB-1F3FF"].Character, "🧑🏻‍🤝‍🧑🏿"; got != want {
+		t.Errorf("ParseEmojiData() character = %v, want %v", got, want)
+	}
+}
+
+func Test_modifierConstant(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"1F3FB", "SkinToneLight"},
+		{"1F3FC-1F3FF", "SkinTones(SkinToneMediumLight, SkinToneDark)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := modifierConstant(tt.input); got != tt.want {
+				t.Errorf("modifierConstant() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_platformSet(t *testing.T) {
+	tests := []struct {
+		data EmojiImageData
+		want string
+	}{
+		{EmojiImageData{}, "0"},
+		{EmojiImageData{HasImgApple: true}, "1<<PlatformApple"},
+		{EmojiImageData{HasImgGoogle: true, HasImgTwitter: true, HasImgFacebook: true}, "1<<PlatformGoogle | 1<<PlatformTwitter | 1<<PlatformFacebook"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := platformSet(tt.data); got != tt.want {
+				t.Errorf("platformSet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
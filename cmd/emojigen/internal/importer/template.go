@@ -9,15 +9,24 @@ import (
 
 const keywordTemplateString = `// Code generated based on latest emoji dataset. DO NOT EDIT.
 
+//go:build emoji_literal
+// +build emoji_literal
+
 package {{ .Package }}
 {{- define "image-data" -}}
-{ {{ .Unified | quote }}, {{ .Character | quote }}, {{ .SheetX }}, {{ .SheetY }}, {{ .AddedIn | quote }}, map[Platform]bool{ PlatformApple: {{ .HasImgApple }}, PlatformGoogle: {{ .HasImgGoogle }}, PlatformTwitter: {{ .HasImgTwitter }}, PlatformFacebook: {{ .HasImgFacebook }} }, {{ .Obsoletes | quote }}, {{ .ObsoletedBy | quote }} }
-{{- end -}}
+{ {{ .Unified | quote }}, {{ .NonQualified | quote }}, {{ .Character | quote }}, {{ .Image | quote }}, {{ .SheetX }}, {{ .SheetY }}, {{ .AddedIn | quote }}, {{ platformSet . }}, {{ .Obsoletes | quote }}, {{ .ObsoletedBy | quote }} }
+{{- end }}
+
+// loadAll returns the dataset that is compiled into the package
+// instead of decoded from the embedded binary dataset.
+func loadAll() []Info {
+	return allLiteral
+}
 
-// AllEmojis contains the list of all available keywords.
-var All = []Info {
+// allLiteral contains the list of all available emojis.
+var allLiteral = []Info {
 	{{- range .Emojis }}
-	{ {{.ShortName | quote }}, {{.Category | quote }}, {{ .Text | quote }}, []string{ {{$s := separator ", "}}{{ range .ShortNames }}{{ call $s }}{{ . | quote }}{{ end }} }, ImageData{{ template "image-data" .EmojiImageData }}, {{ with .SkinVariations }}map[Modifier]ImageData{ {{$s := separator ", "}}{{- range $key, $value := . }}{{ call $s }}{{ $key | modifierConstant }}: {{ template "image-data" $value }}{{- end }}}{{else}}nil{{end}} },
+	{ {{.ShortName | quote }}, {{ .Name | quote }}, {{.Category | quote }}, {{ .Subcategory | quote }}, {{ .SortOrder }}, {{ .Text | quote }}, {{ with .Texts }}[]string{ {{$s := separator ", "}}{{ range . }}{{ call $s }}{{ . | quote }}{{ end }} }{{else}}nil{{end}}, []string{ {{$s := separator ", "}}{{ range .ShortNames }}{{ call $s }}{{ . | quote }}{{ end }} }, {{ with .Keywords }}[]string{ {{$s := separator ", "}}{{ range . }}{{ call $s }}{{ . | quote }}{{ end }} }{{else}}nil{{end}}, ImageData{{ template "image-data" .EmojiImageData }}, {{ with .SkinVariations }}map[Modifier]ImageData{ {{$s := separator ", "}}{{- range $key, $value := . }}{{ call $s }}{{ $key | modifierConstant }}: {{ template "image-data" $value }}{{- end }}}{{else}}nil{{end}} },
 	{{- end }}
 }
 `
@@ -30,12 +39,54 @@ var keywordTemplate = template.Must(
 				"separator":        separator,
 				"quote":            quote,
 				"modifierConstant": modifierConstant,
+				"platformSet":      platformSet,
 			},
 		).
 		Parse(keywordTemplateString),
 )
 
-// RenderTemplate renders the dataset template to the given io.Writer.
+const localeTemplateString = `// Code generated from CLDR annotations. DO NOT EDIT.
+
+package {{ .Package }}
+
+// localizedData maps locales to the localized emoji names and keywords
+// by the unified codepoints of the emoji.
+var localizedData = map[string]map[string]localizedInfo{
+	{{- range $locale, $emojis := .Locales }}
+	{{ $locale | quote }}: {
+		{{- range $emojis }}
+		{{ .Unified | quote }}: { {{ .Name | quote }}, {{ with .Keywords }}[]string{ {{$s := separator ", "}}{{ range . }}{{ call $s }}{{ . | quote }}{{ end }} }{{else}}nil{{end}} },
+		{{- end }}
+	},
+	{{- end }}
+}
+`
+
+var localeTemplate = template.Must(
+	template.
+		New("locales").
+		Funcs(
+			template.FuncMap{
+				"separator": separator,
+				"quote":     quote,
+			},
+		).
+		Parse(localeTemplateString),
+)
+
+// RenderLocaleTemplate renders the localized names and keywords by locale to the given io.Writer.
+func RenderLocaleTemplate(w io.Writer, packageName string, locales map[string][]LocalizedEmoji) error {
+	return localeTemplate.Execute(
+		w,
+		map[string]interface{}{
+			"Package": packageName,
+			"Locales": locales,
+		},
+	)
+}
+
+// RenderTemplate renders the dataset as a Go literal to the given io.Writer.
+// The literal is only built with the emoji_literal build tag, see WriteDataset.
 func RenderTemplate(w io.Writer, packageName string, emojis []EmojiInfo) error {
 	return keywordTemplate.Execute(
 		w,
@@ -86,7 +137,17 @@ func separator(s string) func() string {
 	}
 }
 
+// modifierConstant returns the Go expression for a skin variation key.
+// Keys with a skin tone per person are combined with emoji.SkinTones.
 func modifierConstant(src string) string {
+	tones := strings.Split(src, "-")
+	if len(tones) > 1 {
+		constants := make([]string, len(tones))
+		for i, tone := range tones {
+			constants[i] = modifierConstant(tone)
+		}
+		return fmt.Sprintf("SkinTones(%s)", strings.Join(constants, ", "))
+	}
 	switch src {
 	case "1F3FB":
 		return "SkinToneLight"
@@ -102,3 +163,26 @@ func modifierConstant(src string) string {
 		panic(fmt.Errorf("unsupported modifier string %s", src))
 	}
 }
+
+// platformSet returns the Go constant expression for the platforms
+// that have art for the image, e.g. 1<<PlatformApple | 1<<PlatformGoogle.
+func platformSet(data EmojiImageData) string {
+	var bits []string
+	for _, platform := range []struct {
+		name     string
+		hasImage bool
+	}{
+		{"PlatformApple", data.HasImgApple},
+		{"PlatformGoogle", data.HasImgGoogle},
+		{"PlatformTwitter", data.HasImgTwitter},
+		{"PlatformFacebook", data.HasImgFacebook},
+	} {
+		if platform.hasImage {
+			bits = append(bits, "1<<"+platform.name)
+		}
+	}
+	if len(bits) == 0 {
+		return "0"
+	}
+	return strings.Join(bits, " | ")
+}
@@ -11,6 +11,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/mrosales/emoji-go/cmd/emojigen/internal/importer"
@@ -18,9 +19,13 @@ import (
 
 func main() {
 	var (
-		timeout       time.Duration
-		datasetOutput string
-		imageOutput   string
+		timeout         time.Duration
+		datasetOutput   string
+		literalOutput   string
+		imageOutput     string
+		annotationsPath string
+		localesOutput   string
+		localeFiles     string
 	)
 	flag.DurationVar(
 		&timeout,
@@ -31,12 +36,32 @@ func main() {
 		&datasetOutput,
 		"dataset",
 		"",
-		"file to write generated data to")
+		"file to write the generated binary dataset to")
+	flag.StringVar(
+		&literalOutput,
+		"literal",
+		"",
+		"file to write the generated dataset to as a Go literal, built with the emoji_literal tag")
 	flag.StringVar(
 		&imageOutput,
 		"images",
 		"",
 		"directory to write emoji images to")
+	flag.StringVar(
+		&annotationsPath,
+		"annotations",
+		"",
+		"CLDR annotations file (XML or JSON) or locale to download, e.g. en, to import keywords from")
+	flag.StringVar(
+		&localesOutput,
+		"localized",
+		"",
+		"file to write generated localized names and keywords to")
+	flag.StringVar(
+		&localeFiles,
+		"locales",
+		"",
+		"comma separated locales to download or CLDR annotations files by locale, e.g. de,es or de=de.xml,ja=ja.xml")
 
 	flag.Parse()
 
@@ -53,6 +78,15 @@ func main() {
 	}
 	log.Printf("successfully downloaded %d emojis", len(emojis))
 
+	if len(annotationsPath) > 0 {
+		annotations, err := loadAnnotations(ctx, cdn, annotationsPath)
+		if err != nil {
+			log.Fatalf("failed loading annotations: %v", err)
+		}
+		importer.ApplyKeywords(emojis, annotations)
+		log.Printf("successfully imported keywords for %d emojis", len(annotations))
+	}
+
 	if len(datasetOutput) > 0 {
 		if err := writeDataset(datasetOutput, emojis); err != nil {
 			log.Fatalf("failed writing dataset: %v", err)
@@ -60,6 +94,20 @@ func main() {
 		log.Printf("successfully wrote emoji dataset to %s", datasetOutput)
 	}
 
+	if len(literalOutput) > 0 {
+		if err := writeLiteral(literalOutput, emojis); err != nil {
+			log.Fatalf("failed writing dataset literal: %v", err)
+		}
+		log.Printf("successfully wrote emoji dataset literal to %s", literalOutput)
+	}
+
+	if len(localesOutput) > 0 {
+		if err := writeLocales(ctx, cdn, localesOutput, localeFiles, emojis); err != nil {
+			log.Fatalf("failed writing localized data: %v", err)
+		}
+		log.Printf("successfully wrote localized data to %s", localesOutput)
+	}
+
 	if len(imageOutput) > 0 {
 		sprites, err := cdn.DownloadSpriteSheet(ctx, 64)
 		if err != nil {
@@ -75,21 +123,71 @@ func main() {
 }
 
 func writeDataset(output string, emojis []importer.EmojiInfo) error {
+	buf := &bytes.Buffer{}
+	if err := importer.WriteDataset(buf, emojis); err != nil {
+		return fmt.Errorf("failed encoding dataset: %w", err)
+	}
+	return writeFile(output, buf.Bytes())
+}
+
+func writeLiteral(output string, emojis []importer.EmojiInfo) error {
 	buf := &bytes.Buffer{}
 	if err := importer.RenderTemplate(buf, "emoji", emojis); err != nil {
 		return fmt.Errorf("failed rendering template: %w", err)
 	}
+	return writeFile(output, buf.Bytes())
+}
+
+func writeFile(output string, data []byte) error {
 	dirname := filepath.Dir(output)
 	if err := os.MkdirAll(dirname, 0777); err != nil {
 		return fmt.Errorf("failed creating output directory %s: %v", dirname, err)
 	}
-	if err := ioutil.WriteFile(output, buf.Bytes(), 0777); err != nil {
+	if err := ioutil.WriteFile(output, data, 0777); err != nil {
 		return fmt.Errorf("failed writing file: %v", err)
 	}
 	log.Printf("completed writing data to %s", output)
 	return nil
 }
 
+// loadAnnotations reads the CLDR annotations from an XML or JSON file
+// or downloads the annotations of a locale from the CDN.
+func loadAnnotations(ctx context.Context, cdn *importer.CDN, source string) (importer.Annotations, error) {
+	switch filepath.Ext(source) {
+	case ".xml", ".json":
+		return importer.LoadAnnotations(source)
+	default:
+		return cdn.DownloadAnnotations(ctx, source)
+	}
+}
+
+func writeLocales(ctx context.Context, cdn *importer.CDN, output string, sources string, emojis []importer.EmojiInfo) error {
+	locales := map[string][]importer.LocalizedEmoji{}
+	for _, source := range strings.Split(sources, ",") {
+		if len(source) == 0 {
+			continue
+		}
+		// a locale without a file is downloaded from the CDN
+		parts := strings.SplitN(source, "=", 2)
+		if len(parts) == 1 {
+			parts = append(parts, parts[0])
+		}
+		annotations, err := loadAnnotations(ctx, cdn, parts[1])
+		if err != nil {
+			return err
+		}
+		locales[parts[0]] = importer.Localize(emojis, annotations)
+	}
+	buf := &bytes.Buffer{}
+	if err := importer.RenderLocaleTemplate(buf, "emoji", locales); err != nil {
+		return fmt.Errorf("failed rendering template: %w", err)
+	}
+	if err := ioutil.WriteFile(output, buf.Bytes(), 0777); err != nil {
+		return fmt.Errorf("failed writing file: %v", err)
+	}
+	return nil
+}
+
 func writeSprites(output string, emojis []importer.EmojiInfo, sprites *importer.SpriteSheet) (int, error) {
 	if err := os.MkdirAll(output, 0777); err != nil {
 		return 0, fmt.Errorf("failed creating output directory %s: %v", output, err)
@@ -0,0 +1,202 @@
+package emoji
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"sync"
+)
+
+var (
+	allOnce sync.Once
+	all     []Info
+)
+
+// All returns the list of all available emojis.
+//
+// The dataset is decoded from the embedded binary dataset on first use.
+// Build with the emoji_literal tag to compile it into the package as a Go literal instead.
+// The returned slice is shared and must not be modified.
+//
+// All replaces the All variable of earlier versions of the package,
+// which is a breaking change for callers that index or range over All.
+func All() []Info {
+	allOnce.Do(func() {
+		all = loadAll()
+	})
+	return all
+}
+
+// datasetMagic identifies the binary dataset and its format version.
+// The format is documented by WriteDataset of the emojigen importer.
+const datasetMagic = "EMOJI\x01"
+
+// errInvalidDataset is returned for malformed binary datasets.
+var errInvalidDataset = errors.New("invalid binary emoji dataset")
+
+// decodeDataset decodes the binary dataset written by emojigen.
+func decodeDataset(data []byte) ([]Info, error) {
+	if len(data) < len(datasetMagic) || string(data[:len(datasetMagic)]) != datasetMagic {
+		return nil, errInvalidDataset
+	}
+	d := &datasetDecoder{data: data[len(datasetMagic):], modifiers: map[int]Modifier{}}
+	d.readStrings()
+	infos := make([]Info, d.count())
+	for i := range infos {
+		info := &infos[i]
+		info.Name = d.string()
+		info.FullName = d.string()
+		info.Category = Category(d.string())
+		info.Subcategory = Subcategory(d.string())
+		info.SortOrder = d.uint()
+		info.PlainText = d.string()
+		info.Texts = d.strings()
+		info.AlternateNames = d.strings()
+		info.Keywords = d.strings()
+		info.ImageData = d.imageData()
+		if n := d.count(); n > 0 {
+			info.SkinVariations = make(map[Modifier]ImageData, n)
+			for j := 0; j < n; j++ {
+				mod := d.modifier()
+				info.SkinVariations[mod] = d.imageData()
+			}
+		}
+	}
+	if d.err != nil || len(d.data) > 0 {
+		return nil, errInvalidDataset
+	}
+	return infos, nil
+}
+
+// datasetDecoder reads the values of a binary dataset. The first error
+// is kept and makes all subsequent reads return zero values.
+type datasetDecoder struct {
+	data []byte
+	err  error
+	// table is the string table, whose strings share a single allocation.
+	table []string
+	// pool is carved up for the string slices of the emojis.
+	pool []string
+	// modifiers caches the parsed skin variation keys by string index.
+	modifiers map[int]Modifier
+}
+
+func (d *datasetDecoder) fail() {
+	if d.err == nil {
+		d.err = errInvalidDataset
+	}
+	d.data = nil
+}
+
+func (d *datasetDecoder) uint() int {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.data)
+	if n <= 0 || v > math.MaxInt32 {
+		d.fail()
+		return 0
+	}
+	d.data = d.data[n:]
+	return int(v)
+}
+
+// count reads a number of elements, which cannot exceed the remaining bytes.
+func (d *datasetDecoder) count() int {
+	n := d.uint()
+	if n > len(d.data) {
+		d.fail()
+		return 0
+	}
+	return n
+}
+
+func (d *datasetDecoder) readStrings() {
+	lengths := make([]int, d.count())
+	size := 0
+	for i := range lengths {
+		lengths[i] = d.count()
+		size += lengths[i]
+	}
+	if d.err != nil || size > len(d.data) {
+		d.fail()
+		return
+	}
+	text := string(d.data[:size])
+	d.data = d.data[size:]
+	d.table = make([]string, len(lengths))
+	for i, n := range lengths {
+		d.table[i], text = text[:n], text[n:]
+	}
+}
+
+func (d *datasetDecoder) string() string {
+	idx := d.uint()
+	if idx >= len(d.table) {
+		d.fail()
+		return ""
+	}
+	return d.table[idx]
+}
+
+// strings reads a list of strings, which is nil if it is empty.
+func (d *datasetDecoder) strings() []string {
+	n := d.count()
+	if n == 0 {
+		return nil
+	}
+	if len(d.pool) < n {
+		d.pool = make([]string, n+1024)
+	}
+	values := d.pool[:n:n]
+	d.pool = d.pool[n:]
+	for i := range values {
+		values[i] = d.string()
+	}
+	return values
+}
+
+func (d *datasetDecoder) modifier() Modifier {
+	idx := d.uint()
+	if mod, ok := d.modifiers[idx]; ok {
+		return mod
+	}
+	if idx >= len(d.table) {
+		d.fail()
+		return SkinToneNone
+	}
+	mod, err := NewModifier(d.table[idx])
+	if err != nil || mod == SkinToneNone {
+		d.fail()
+		return SkinToneNone
+	}
+	d.modifiers[idx] = mod
+	return mod
+}
+
+func (d *datasetDecoder) imageData() ImageData {
+	return ImageData{
+		Unified:         d.string(),
+		NonQualified:    d.string(),
+		Character:       d.string(),
+		Image:           d.string(),
+		SheetX:          d.uint(),
+		SheetY:          d.uint(),
+		AddedIn:         d.string(),
+		PlatformSupport: datasetPlatforms(d.uint()),
+		Obsoletes:       d.string(),
+		ObsoletedBy:     d.string(),
+	}
+}
+
+// datasetPlatforms returns the PlatformSet of a platform bitmask in the binary dataset,
+// whose bits are Apple, Google, Twitter and Facebook from least significant.
+func datasetPlatforms(bits int) PlatformSet {
+	var s PlatformSet
+	for i, p := range [...]Platform{PlatformApple, PlatformGoogle, PlatformTwitter, PlatformFacebook} {
+		if bits&(1<<i) != 0 {
+			s |= p.bit()
+		}
+	}
+	return s
+}
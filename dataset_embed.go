@@ -0,0 +1,23 @@
+//go:build !emoji_literal
+// +build !emoji_literal
+
+package emoji
+
+import (
+	_ "embed"
+	"fmt"
+)
+
+// dataset is the binary dataset generated by emojigen.
+//
+//go:embed data.bin
+var dataset []byte
+
+// loadAll decodes the embedded binary dataset.
+func loadAll() []Info {
+	infos, err := decodeDataset(dataset)
+	if err != nil {
+		panic(fmt.Errorf("failed decoding embedded dataset: %w", err))
+	}
+	return infos
+}
@@ -0,0 +1,26 @@
+//go:build !emoji_literal
+// +build !emoji_literal
+
+package emoji
+
+import (
+	"os/exec"
+	"testing"
+)
+
+// TestDecodeDataset_Literal compares the embedded binary dataset with the
+// Go literal, which is only compiled with the emoji_literal tag, by running
+// the test of the same name with the tag.
+func TestDecodeDataset_Literal(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping build of the Go literal in short mode")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go tool not found")
+	}
+	cmd := exec.Command(goTool, "test", "-tags", "emoji_literal", "-run", "^TestDecodeDataset_Literal$", ".")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("binary dataset differs from the Go literal: %v\n%s", err, out)
+	}
+}
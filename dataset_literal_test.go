@@ -0,0 +1,29 @@
+//go:build emoji_literal
+// +build emoji_literal
+
+package emoji
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeDataset_Literal(t *testing.T) {
+	data, err := os.ReadFile("data.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	infos, err := decodeDataset(data)
+	if err != nil {
+		t.Fatalf("decodeDataset() error = %v", err)
+	}
+	if len(infos) != len(allLiteral) {
+		t.Fatalf("decodeDataset() returned %d emojis, want %d", len(infos), len(allLiteral))
+	}
+	for i := range infos {
+		if !reflect.DeepEqual(infos[i], allLiteral[i]) {
+			t.Errorf("decodeDataset()[%d] = %+v, want %+v", i, infos[i], allLiteral[i])
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package emoji
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestDecodeDataset(t *testing.T) {
+	data, err := os.ReadFile("data.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := decodeDataset(data); err != nil {
+		t.Fatalf("decodeDataset() error = %v", err)
+	}
+	info, mod, ok := ByCharacter("👋🏽")
+	if !ok {
+		t.Fatal("ByCharacter() found no emoji")
+	}
+	image := info.ImageForModifier(mod)
+	if info.Name != "wave" || image.Unified != "1F44B-1F3FD" || !image.PlatformSupport.Has(PlatformApple) {
+		t.Errorf("ByCharacter() = %s with image %+v", info.Name, image)
+	}
+	for _, n := range []int{0, 3, len(datasetMagic), len(data) / 2, len(data) - 1} {
+		if _, err := decodeDataset(data[:n]); err == nil {
+			t.Errorf("decodeDataset() of %d bytes expected error", n)
+		}
+	}
+	if _, err := decodeDataset(append(data[:len(data):len(data)], 0)); err == nil {
+		t.Error("decodeDataset() with trailing data expected error")
+	}
+}
+
+// BenchmarkDecodeDataset reports the time and heap allocations
+// of decoding the binary dataset on the first use of All.
+func BenchmarkDecodeDataset(b *testing.B) {
+	data, err := os.ReadFile("data.bin")
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ReportMetric(float64(len(data)), "dataset-B")
+	for i := 0; i < b.N; i++ {
+		if _, err := decodeDataset(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkBinarySize reports the size of the emoji CLI built
+// with the embedded binary dataset and with the Go literal.
+func BenchmarkBinarySize(b *testing.B) {
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		b.Skip("go tool not found")
+	}
+	for _, mode := range []struct {
+		name string
+		tags string
+	}{
+		{"binary", ""},
+		{"literal", "emoji_literal"},
+	} {
+		b.Run(mode.name, func(b *testing.B) {
+			output := filepath.Join(b.TempDir(), "emoji")
+			for i := 0; i < b.N; i++ {
+				cmd := exec.Command(goTool, "build", "-tags", mode.tags, "-o", output, "./cmd/emoji")
+				if out, err := cmd.CombinedOutput(); err != nil {
+					b.Fatalf("failed building: %v\n%s", err, out)
+				}
+			}
+			stat, err := os.Stat(output)
+			if err != nil {
+				b.Fatal(err)
+			}
+			b.ReportMetric(float64(stat.Size()), "binary-B")
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package emoji
+
+import "strings"
+
+// zeroWidthJoiner joins several emojis into a single emoji sequence.
+const zeroWidthJoiner = "\u200d"
+
+// Downgrade rewrites the emojis in the text that were added after maxVersion,
+// so that clients that only support emojis up to that version can display the text.
+//
+// Every unsupported emoji is replaced by the first of these fallbacks that is supported:
+//
+//   - the components of a zero-width-joiner sequence, e.g. 🏳️‍🌈 becomes 🏳️🌈
+//   - the emoji without its skin tone
+//   - the emoji that obsoletes it or that it obsoletes, e.g. 🏃‍♂️ becomes 🏃
+//   - the shortcode, e.g. :melting_face:
+func Downgrade(text string, maxVersion Version) string {
+	return getDefaultScanner().ReplaceFunc(text, func(m Match) string {
+		s := text[m.Start:m.End]
+		if downgraded, ok := downgradeEmoji(s, m.Info, m.Modifier, maxVersion); ok {
+			return downgraded
+		}
+		return getDefaultEmojizer().Demojize(s)
+	})
+}
+
+// downgradeEmoji returns the emoji or a fallback that was added in maxVersion or earlier,
+// or false if every fallback other than the shortcode is too new.
+func downgradeEmoji(s string, info Info, mod Modifier, maxVersion Version) (string, bool) {
+	image := info.ImageForModifier(mod)
+	if image.Version().Compare(maxVersion) <= 0 {
+		return s, true
+	}
+	if components, ok := downgradeComponents(s, maxVersion); ok {
+		return components, true
+	}
+	if mod != SkinToneNone && info.Version().Compare(maxVersion) <= 0 {
+		return info.Character, true
+	}
+	for _, unified := range []string{image.ObsoletedBy, image.Obsoletes, info.ObsoletedBy, info.Obsoletes} {
+		partner, partnerMod, ok := ByUnified(unified)
+		if !ok {
+			continue
+		}
+		// the partner of the emoji keeps the skin tone if it supports it
+		if partnerMod == SkinToneNone {
+			partnerMod = mod
+		}
+		for _, partnerImage := range []ImageData{partner.ImageForModifier(partnerMod), partner.ImageData} {
+			if partnerImage.Version().Compare(maxVersion) <= 0 {
+				return partnerImage.Character, true
+			}
+		}
+	}
+	return "", false
+}
+
+// downgradeComponents returns the downgraded components of a zero-width-joiner sequence
+// without the joiners, or false if the text is not a sequence or a component is too new.
+func downgradeComponents(s string, maxVersion Version) (string, bool) {
+	components := strings.Split(s, zeroWidthJoiner)
+	if len(components) < 2 {
+		return "", false
+	}
+	sb := strings.Builder{}
+	for _, component := range components {
+		info, mod, ok := ByCharacter(component)
+		if !ok {
+			return "", false
+		}
+		downgraded, ok := downgradeEmoji(component, info, mod, maxVersion)
+		if !ok {
+			return "", false
+		}
+		sb.WriteString(downgraded)
+	}
+	return sb.String(), true
+}
@@ -0,0 +1,29 @@
+package emoji
+
+import "testing"
+
+func TestDowngrade(t *testing.T) {
+	tests := []struct {
+		name       string
+		text       string
+		maxVersion Version
+		want       string
+	}{
+		{"supported", "launch 🚀 👋🏽", Version{1, 0}, "launch 🚀 👋🏽"},
+		{"zwj components", "🏳️‍🌈", Version{3, 0}, "🏳️🌈"},
+		{"zwj components with skin tone", "👩🏽‍💻", Version{3, 0}, "👩🏽💻"},
+		{"without skin tone", "👋🏽", Version{0, 6}, "👋"},
+		{"obsoleted partner", "🏃‍♂️", Version{3, 0}, "🏃"},
+		{"obsoleted partner with skin tone", "🏃🏽‍♂️", Version{3, 0}, "🏃🏽"},
+		{"shortcode", "so tired 🥱", Version{11, 0}, "so tired :yawning_face:"},
+		{"zwj component too new", "🧑‍🚀", Version{4, 0}, ":astronaut:"},
+		{"newer version", "🧑‍🚀 🥱", Version{13, 0}, "🧑‍🚀 🥱"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Downgrade(tt.text, tt.maxVersion); got != tt.want {
+				t.Errorf("Downgrade() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,371 @@
+package emoji
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// skinToneCodePrefix is the prefix of shortcodes that select a skin tone,
+	// such as :skin-tone-4: in :wave::skin-tone-4:.
+	skinToneCodePrefix = "skin-tone-"
+	// maxSkinToneCodeLength is the length of the longest skin tone shortcode.
+	maxSkinToneCodeLength = len(":" + skinToneCodePrefix + "medium_light:")
+	// maxSkinToneCodes is the maximum number of skin tone shortcodes following
+	// an emoji, one for each person shown by the emoji.
+	maxSkinToneCodes = 2
+)
+
+var (
+	defaultEmojizer     *Emojizer
+	defaultEmojizerOnce sync.Once
+)
+
+// Emojize replaces every known shortcode in the text, such as :rocket:,
+// with the matching emoji character using the default Emojizer.
+func Emojize(text string) string {
+	return getDefaultEmojizer().Emojize(text)
+}
+
+// Demojize replaces every emoji character in the text with its shortcode
+// using the default Emojizer.
+func Demojize(text string) string {
+	return getDefaultEmojizer().Demojize(text)
+}
+
+func getDefaultEmojizer() *Emojizer {
+	defaultEmojizerOnce.Do(func() {
+		defaultEmojizer = NewEmojizer()
+	})
+	return defaultEmojizer
+}
+
+// Emojizer converts between shortcodes in text and emoji characters.
+type Emojizer struct {
+	options emojizerOptionSet
+	// registry provides the emojis if the Emojizer was created from a Registry.
+	registry *Registry
+	// mu guards the tables, which are rebuilt when the registry changes.
+	mu      sync.Mutex
+	tables  *emojizerTables
+	version uint64
+}
+
+// emojizerTables contains the shortcodes and characters of the emojis.
+type emojizerTables struct {
+	infos []Info
+	// codes maps a shortcode to the index of the emoji in infos.
+	codes map[string]int
+	// names is the shortcode of each emoji in infos.
+	names []string
+	// sequences contains the characters of every emoji in infos.
+	sequences *sequenceNode
+	// maxCodeLength is the length of the longest shortcode including a skin tone.
+	maxCodeLength int
+	// maxSequenceLength is the length of the longest emoji character sequence.
+	maxSequenceLength int
+}
+
+// NewEmojizer creates an Emojizer for the emoji dataset.
+func NewEmojizer(opts ...EmojizerOption) *Emojizer {
+	options := emojizerOptionSet{
+		AlternateNames: true,
+	}
+	for _, optionFunc := range opts {
+		optionFunc(&options)
+	}
+	return &Emojizer{
+		options: options,
+		tables:  newEmojizerTables(All(), options.AlternateNames),
+	}
+}
+
+func newEmojizerTables(infos []Info, alternateNames bool) *emojizerTables {
+	codes := make(map[string]int, len(infos))
+	// canonical names take precedence over alternate names
+	for i, info := range infos {
+		if _, exists := codes[info.Name]; !exists {
+			codes[info.Name] = i
+		}
+	}
+	if alternateNames {
+		for i, info := range infos {
+			for _, name := range info.AlternateNames {
+				if _, exists := codes[name]; !exists {
+					codes[name] = i
+				}
+			}
+		}
+	}
+
+	maxCodeLength := 0
+	for code := range codes {
+		if len(code) > maxCodeLength {
+			maxCodeLength = len(code)
+		}
+	}
+	maxCodeLength += len("::") + maxSkinToneCodes*maxSkinToneCodeLength
+
+	// prefer a shortcode that converts back to the same emoji
+	names := make([]string, len(infos))
+	for i, info := range infos {
+		names[i] = info.Name
+		if codes[info.Name] == i {
+			continue
+		}
+		for _, name := range info.AlternateNames {
+			if idx, ok := codes[name]; ok && idx == i {
+				names[i] = name
+				break
+			}
+		}
+	}
+	sequences, maxSequenceLength := newSequenceTrie(infos)
+	return &emojizerTables{
+		infos:             infos,
+		codes:             codes,
+		names:             names,
+		sequences:         sequences,
+		maxCodeLength:     maxCodeLength,
+		maxSequenceLength: maxSequenceLength,
+	}
+}
+
+// current returns the tables of the emojis, rebuilding them if the registry changed.
+func (e *Emojizer) current() *emojizerTables {
+	if e.registry == nil {
+		return e.tables
+	}
+	infos, version := e.registry.snapshot()
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.version != version {
+		e.tables = newEmojizerTables(infos, e.options.AlternateNames)
+		e.version = version
+	}
+	return e.tables
+}
+
+// Emojize replaces every known shortcode in the text, such as :rocket:,
+// with the matching emoji character.
+//
+// A skin tone shortcode directly following an emoji that supports skin tones
+// selects the variation, e.g. :wave::skin-tone-4: becomes 👋🏽. Emojis that
+// show several people may be followed by a skin tone shortcode per person.
+// Unknown shortcodes are left untouched.
+func (e *Emojizer) Emojize(text string) string {
+	sb := strings.Builder{}
+	sb.Grow(len(text))
+	e.emojize(&sb, text, true)
+	return sb.String()
+}
+
+// emojize writes the converted text to the builder and returns the number of
+// bytes that were consumed. Unless atEOF is set, it stops before a shortcode
+// that could continue beyond the end of the text.
+func (e *Emojizer) emojize(sb *strings.Builder, text string, atEOF bool) int {
+	t := e.current()
+	for i := 0; i < len(text); {
+		if text[i] == ':' {
+			if !atEOF && i+t.maxCodeLength > len(text) {
+				return i
+			}
+			if chr, n, ok := e.matchShortcode(t, text[i:]); ok {
+				sb.WriteString(chr)
+				i += n
+				continue
+			}
+		}
+		sb.WriteByte(text[i])
+		i++
+	}
+	return len(text)
+}
+
+// Demojize replaces every emoji character in the text with its shortcode,
+// e.g. 🚀 becomes :rocket:.
+//
+// The longest matching sequence is replaced, so a zero-width-joiner sequence
+// such as 👨‍👩‍👧 is not split into its parts. Skin tone variations are
+// written as the shortcode of the emoji followed by a skin tone shortcode,
+// e.g. 👋🏽 becomes :wave::skin-tone-medium:. Emojis with a skin tone per
+// person are followed by a skin tone shortcode for each person.
+func (e *Emojizer) Demojize(text string) string {
+	sb := strings.Builder{}
+	sb.Grow(len(text))
+	e.demojize(&sb, text, true)
+	return sb.String()
+}
+
+// demojize writes the converted text to the builder and returns the number of
+// bytes that were consumed. Unless atEOF is set, it stops before a sequence
+// that could continue beyond the end of the text.
+func (e *Emojizer) demojize(sb *strings.Builder, text string, atEOF bool) int {
+	t := e.current()
+	for i := 0; i < len(text); {
+		if !atEOF && i+t.maxSequenceLength > len(text) {
+			if !utf8.FullRuneInString(text[i:]) {
+				return i
+			}
+			if r, _ := utf8.DecodeRuneInString(text[i:]); t.sequences.children[r] != nil {
+				return i
+			}
+		}
+		if match, n, ok := t.sequences.longestMatch(text[i:]); ok {
+			t.writeShortcode(sb, match)
+			i += n
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(text[i:])
+		sb.WriteString(text[i : i+size])
+		i += size
+	}
+	return len(text)
+}
+
+// writeShortcode writes the shortcode for the matched emoji and
+// the skin tone shortcode if a modifier is set.
+func (t *emojizerTables) writeShortcode(sb *strings.Builder, match sequenceMatch) {
+	sb.WriteByte(':')
+	sb.WriteString(t.names[match.index])
+	sb.WriteByte(':')
+	for _, tone := range match.modifier.Tones() {
+		sb.WriteByte(':')
+		sb.WriteString(skinToneCodePrefix)
+		sb.WriteString(tone.String())
+		sb.WriteByte(':')
+	}
+}
+
+// matchShortcode returns the emoji character for the shortcode at the start of
+// the text along with the number of bytes that were consumed.
+// Custom emojis are written with the CustomEmojiFunc if one is configured.
+func (e *Emojizer) matchShortcode(t *emojizerTables, text string) (string, int, bool) {
+	code, ok := shortcode(text)
+	if !ok {
+		return "", 0, false
+	}
+	idx, ok := t.codes[code]
+	if !ok {
+		return "", 0, false
+	}
+	info := t.infos[idx]
+	n := len(code) + 2
+	if info.IsCustom() {
+		if e.options.CustomEmojiFunc == nil {
+			return "", 0, false
+		}
+		return e.options.CustomEmojiFunc(info), n, true
+	}
+
+	mod := e.options.SkinTone
+	if len(info.SkinVariations) > 0 {
+		if tones, length := parseSkinToneCodes(text[n:]); len(tones) > 0 {
+			mod = SkinTones(tones...)
+			n += length
+		}
+	}
+	return info.ImageForModifier(mod).Character, n, true
+}
+
+// shortcode returns the code between the colons of a shortcode
+// at the start of the text.
+func shortcode(text string) (string, bool) {
+	if len(text) < 3 || text[0] != ':' {
+		return "", false
+	}
+	end := strings.IndexByte(text[1:], ':')
+	if end <= 0 {
+		return "", false
+	}
+	code := text[1 : end+1]
+	if strings.IndexFunc(code, unicode.IsSpace) >= 0 {
+		return "", false
+	}
+	return code, true
+}
+
+// parseSkinToneCodes parses the skin tone shortcodes at the start of the text,
+// e.g. :skin-tone-2::skin-tone-6:, and returns the skin tones along with the
+// number of bytes that were consumed.
+func parseSkinToneCodes(text string) ([]Modifier, int) {
+	var (
+		tones  []Modifier
+		length int
+	)
+	for len(tones) < maxSkinToneCodes {
+		code, ok := shortcode(text[length:])
+		if !ok {
+			break
+		}
+		tone, ok := parseSkinToneCode(code)
+		if !ok {
+			break
+		}
+		tones = append(tones, tone)
+		length += len(code) + 2
+	}
+	return tones, length
+}
+
+// parseSkinToneCode parses the skin tone from a shortcode such as skin-tone-4
+// or skin-tone-medium.
+func parseSkinToneCode(code string) (Modifier, bool) {
+	if !strings.HasPrefix(code, skinToneCodePrefix) {
+		return SkinToneNone, false
+	}
+	tone := strings.TrimPrefix(code, skinToneCodePrefix)
+	switch tone {
+	case "2":
+		return SkinToneLight, true
+	case "3":
+		return SkinToneMediumLight, true
+	case "4":
+		return SkinToneMedium, true
+	case "5":
+		return SkinToneMediumDark, true
+	case "6":
+		return SkinToneDark, true
+	}
+	mod, err := NewModifier(tone)
+	if err != nil || mod == SkinToneNone {
+		return SkinToneNone, false
+	}
+	return mod, true
+}
+
+// emojizerOptionSet collects values from multiple emojizer options.
+type emojizerOptionSet struct {
+	SkinTone        Modifier
+	AlternateNames  bool
+	CustomEmojiFunc func(Info) string
+}
+
+// EmojizerOption represents an option that configures an Emojizer.
+type EmojizerOption func(option *emojizerOptionSet)
+
+// WithSkinTone sets the skin tone used for emojis that support skin tones
+// when the text does not select one explicitly.
+func WithSkinTone(mod Modifier) EmojizerOption {
+	return func(option *emojizerOptionSet) {
+		option.SkinTone = mod
+	}
+}
+
+// WithAlternateNames configures whether alternate names are recognized
+// as shortcodes in addition to the canonical name. Enabled by default.
+func WithAlternateNames(enabled bool) EmojizerOption {
+	return func(option *emojizerOptionSet) {
+		option.AlternateNames = enabled
+	}
+}
+
+// WithCustomEmojiFunc sets how the shortcodes of custom emojis from a Registry
+// are written, e.g. as an HTML image tag. By default they are left untouched.
+func WithCustomEmojiFunc(fn func(Info) string) EmojizerOption {
+	return func(option *emojizerOptionSet) {
+		option.CustomEmojiFunc = fn
+	}
+}
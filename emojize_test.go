@@ -0,0 +1,147 @@
+package emoji
+
+import "testing"
+
+func TestEmojize(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		options []EmojizerOption
+		want    string
+	}{
+		{
+			"single code",
+			":rocket: launch",
+			nil,
+			"🚀 launch",
+		},
+		{
+			"alternate name",
+			"nice :thumbsup:",
+			nil,
+			"nice 👍",
+		},
+		{
+			"alternate names disabled",
+			"nice :thumbsup:",
+			[]EmojizerOption{WithAlternateNames(false)},
+			"nice :thumbsup:",
+		},
+		{
+			"adjacent codes",
+			":rocket::rocket:",
+			nil,
+			"🚀🚀",
+		},
+		{
+			"numeric skin tone",
+			":wave::skin-tone-4:",
+			nil,
+			"👋🏽",
+		},
+		{
+			"named skin tone",
+			":wave::skin-tone-medium_dark:",
+			nil,
+			"👋🏾",
+		},
+		{
+			"default skin tone",
+			":wave: :rocket:",
+			[]EmojizerOption{WithSkinTone(SkinToneDark)},
+			"👋🏿 🚀",
+		},
+		{
+			"skin tone per person",
+			":people_holding_hands::skin-tone-2::skin-tone-6:",
+			nil,
+			"🧑🏻‍🤝‍🧑🏿",
+		},
+		{
+			"skin tone after unsupported emoji",
+			":rocket::skin-tone-2:",
+			nil,
+			"🚀🏻",
+		},
+		{
+			"unknown code",
+			"time is 10:30:00 :fubar:",
+			nil,
+			"time is 10:30:00 :fubar:",
+		},
+		{
+			"code after stray colon",
+			"note: :rocket:",
+			nil,
+			"note: 🚀",
+		},
+		{
+			"whitespace is not a code",
+			": rocket :",
+			nil,
+			": rocket :",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewEmojizer(tt.options...).Emojize(tt.text); got != tt.want {
+				t.Errorf("Emojize() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmojizeDefault(t *testing.T) {
+	if got, want := Emojize(":rocket: launch"), "🚀 launch"; got != want {
+		t.Errorf("Emojize() = %q, want %q", got, want)
+	}
+}
+
+func TestDemojize(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{
+			"single emoji",
+			"🚀 launch",
+			":rocket: launch",
+		},
+		{
+			"skin tone variation",
+			"👋🏽",
+			":wave::skin-tone-medium:",
+		},
+		{
+			"zero width joiner sequence",
+			"👨‍👩‍👧!",
+			":man_woman_girl:!",
+		},
+		{
+			"flag",
+			"🇺🇸",
+			":us:",
+		},
+		{
+			"duplicate name",
+			"👍👎",
+			":_1::-1:",
+		},
+		{
+			"no emoji",
+			"plain ascii: text",
+			"plain ascii: text",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Demojize(tt.text); got != tt.want {
+				t.Errorf("Demojize() = %q, want %q", got, tt.want)
+			}
+			if got := Emojize(Demojize(tt.text)); got != tt.text {
+				t.Errorf("Emojize(Demojize()) = %q, want %q", got, tt.text)
+			}
+		})
+	}
+}
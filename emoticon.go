@@ -0,0 +1,153 @@
+package emoji
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// EmoticonConverter converts between emoticons such as :-) or <3 and emoji characters.
+//
+// Emoticons are taken from the Texts and PlainText of each emoji. Emoticons
+// with eyes, such as :), are also recognized with a nose, as in :-), and
+// the other way around.
+type EmoticonConverter struct {
+	// emoticons maps an emoticon to the index of the emoji in All.
+	emoticons map[string]int
+	// maxLength is the length of the longest emoticon.
+	maxLength int
+	scanner   *Scanner
+}
+
+// NewEmoticonConverter creates an EmoticonConverter for the emoji dataset.
+func NewEmoticonConverter() *EmoticonConverter {
+	infos := All()
+	emoticons := map[string]int{}
+	// fromTexts contains the emoticons that were added from Texts
+	fromTexts := map[string]bool{}
+	maxLength := 0
+	add := func(text string, idx int, fromText bool) {
+		for _, variant := range emoticonVariants(text) {
+			if existing, exists := emoticons[variant]; exists &&
+				(fromTexts[variant] || infos[existing].SortOrder <= infos[idx].SortOrder) {
+				continue
+			}
+			emoticons[variant] = idx
+			fromTexts[variant] = fromText
+			if len(variant) > maxLength {
+				maxLength = len(variant)
+			}
+		}
+	}
+	// texts identify the emoji an emoticon converts to and take precedence
+	// over the plaintext representation, which may be shared by several emojis,
+	// e.g. <3 by every colored heart, where the first emoji in the official order wins
+	for i, info := range infos {
+		for _, text := range info.Texts {
+			add(text, i, true)
+		}
+	}
+	for i, info := range infos {
+		if len(info.PlainText) > 0 {
+			add(info.PlainText, i, false)
+		}
+	}
+	return &EmoticonConverter{
+		emoticons: emoticons,
+		maxLength: maxLength,
+		scanner:   NewScanner(),
+	}
+}
+
+// ToEmoji replaces emoticons in the text with emoji characters.
+//
+// An emoticon is only replaced if it is a separate word, i.e. it is surrounded
+// by whitespace or punctuation ending a sentence, so URLs and code such as
+// http://example.com or a?b:c are left untouched.
+func (c *EmoticonConverter) ToEmoji(text string) string {
+	sb := strings.Builder{}
+	sb.Grow(len(text))
+	wordStart := true
+	for i := 0; i < len(text); {
+		if wordStart {
+			if chr, n, ok := c.matchEmoticon(text[i:]); ok {
+				sb.WriteString(chr)
+				i += n
+				wordStart = false
+				continue
+			}
+		}
+		r, size := utf8.DecodeRuneInString(text[i:])
+		sb.WriteString(text[i : i+size])
+		i += size
+		wordStart = unicode.IsSpace(r)
+	}
+	return sb.String()
+}
+
+// matchEmoticon returns the emoji character for the longest emoticon at the
+// start of the text along with the number of bytes that were consumed.
+func (c *EmoticonConverter) matchEmoticon(text string) (string, int, bool) {
+	n := c.maxLength
+	if n > len(text) {
+		n = len(text)
+	}
+	for ; n > 0; n-- {
+		idx, ok := c.emoticons[text[:n]]
+		if !ok || !isEmoticonBoundary(text[n:]) {
+			continue
+		}
+		return All()[idx].Character, n, true
+	}
+	return "", 0, false
+}
+
+// ToEmoticons replaces emoji characters in the text with their plaintext
+// representation. Emojis without a plaintext representation are left untouched.
+func (c *EmoticonConverter) ToEmoticons(text string) string {
+	return c.scanner.ReplaceFunc(text, func(m Match) string {
+		if len(m.Info.PlainText) > 0 {
+			return m.Info.PlainText
+		}
+		if len(m.Info.Texts) > 0 {
+			return m.Info.Texts[0]
+		}
+		return text[m.Start:m.End]
+	})
+}
+
+// isEmoticonBoundary reports whether an emoticon may be followed by the text.
+func isEmoticonBoundary(text string) bool {
+	if len(text) == 0 {
+		return true
+	}
+	r, _ := utf8.DecodeRuneInString(text)
+	if unicode.IsSpace(r) {
+		return true
+	}
+	switch r {
+	case '.', ',', '!', '?', ';':
+		return isEmoticonBoundary(text[1:])
+	default:
+		return false
+	}
+}
+
+// emoticonVariants returns the emoticon along with variations that add or
+// remove a nose and use an uppercase mouth, e.g. :p, :-p, :P and :-P.
+func emoticonVariants(text string) []string {
+	variants := []string{text}
+	if len(text) >= 2 && strings.ContainsRune(":;=", rune(text[0])) {
+		if text[1] == '-' && len(text) >= 3 {
+			variants = append(variants, text[:1]+text[2:])
+		} else if text[1] != '-' {
+			variants = append(variants, text[:1]+"-"+text[1:])
+		}
+	}
+	for _, variant := range variants {
+		if upper := strings.ToUpper(variant); upper != variant {
+			variants = append(variants, upper)
+		}
+	}
+	return variants
+}
@@ -0,0 +1,81 @@
+package emoji
+
+import "testing"
+
+func TestEmoticonConverter_ToEmoji(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{
+			"emoticons",
+			"great :D see you ;) :'(",
+			"great 😀 see you 😉 😢",
+		},
+		{
+			"plaintext shared by several emojis",
+			"<3 </3",
+			"❤️ 💔",
+		},
+		{
+			"nose and uppercase variants",
+			":-D ;-P :P",
+			"😀 😜 😛",
+		},
+		{
+			"sentence punctuation",
+			"done :D. next ;)!",
+			"done 😀. next 😉!",
+		},
+		{
+			"urls and code",
+			"http://example.com/:D a?b:(c) x:p",
+			"http://example.com/:D a?b:(c) x:p",
+		},
+		{
+			"no trailing boundary",
+			":Dx ;)foo",
+			":Dx ;)foo",
+		},
+	}
+	c := NewEmoticonConverter()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c.ToEmoji(tt.text); got != tt.want {
+				t.Errorf("ToEmoji() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmoticonConverter_ToEmojiTexts(t *testing.T) {
+	// find a text form that belongs to a single emoji and is not a PlainText
+	owners := map[string]int{}
+	plainTexts := map[string]bool{}
+	for _, info := range All() {
+		plainTexts[info.PlainText] = true
+		for _, text := range info.Texts {
+			owners[text]++
+		}
+	}
+	for _, info := range All() {
+		for _, text := range info.Texts {
+			if owners[text] != 1 || plainTexts[text] {
+				continue
+			}
+			if got := NewEmoticonConverter().ToEmoji(text); got != info.Character {
+				t.Errorf("ToEmoji(%q) = %q, want %q", text, got, info.Character)
+			}
+			return
+		}
+	}
+	t.Skip("the dataset has no text forms besides PlainText, regenerate it with go generate")
+}
+
+func TestEmoticonConverter_ToEmoticons(t *testing.T) {
+	c := NewEmoticonConverter()
+	if got, want := c.ToEmoticons("I ❤️ go 😃 🚀"), "I <3 go :) 🚀"; got != want {
+		t.Errorf("ToEmoticons() = %q, want %q", got, want)
+	}
+}
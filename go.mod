@@ -5,5 +5,5 @@ go 1.16
 require (
 	github.com/lithammer/fuzzysearch v1.1.1
 	github.com/spf13/cobra v1.1.3
-	golang.org/x/text v0.3.5 // indirect
+	golang.org/x/text v0.3.5
 )
@@ -1,6 +1,6 @@
 package emoji
 
-//go:generate go run ./cmd/emojigen -dataset data.go
+//go:generate go run ./cmd/emojigen -dataset data.bin -literal data.go -annotations en -localized locales.go -locales de,es,ja
 
 import (
 	"fmt"
@@ -10,12 +10,23 @@ import (
 type Info struct {
 	// Name is the canonical short name.
 	Name string `json:"name"`
+	// FullName is the official human-readable name, e.g. "grinning face".
+	FullName string `json:"full_name"`
 	// Category is the category of emoji.
-	Category string `json:"category"`
+	Category Category `json:"category"`
+	// Subcategory is the subcategory of the emoji within the category.
+	Subcategory Subcategory `json:"subcategory,omitempty"`
+	// SortOrder is the position of the emoji in the official emoji ordering.
+	SortOrder int `json:"sort_order"`
 	// PlainText is defined if the character has a canonical plaintext representation.
 	PlainText string `json:"plain_text,omitempty"`
+	// Texts is the list of plaintext representations that are commonly
+	// converted to the emoji, such as emoticons.
+	Texts []string `json:"texts,omitempty"`
 	// AlternateNames is a list of alternative string names or keywords
 	AlternateNames []string `json:"alternate_names,omitempty"`
+	// Keywords is a list of words that describe the emoji, e.g. "space" for 🚀.
+	Keywords []string `json:"keywords,omitempty"`
 	// ImageData is an embedded struct with details about the actual image.
 	ImageData
 	// SkinVariations is the map of alternative emojis for different skin tones.
@@ -27,16 +38,21 @@ type ImageData struct {
 	// A hyphen separated sequence of hex-encoded codepoints.
 	// Includes zero-width-joiner character for multi code sequences.
 	Unified string `json:"unified"`
+	// NonQualified is set if the emoji is also used without the variation selector.
+	// It has the same format as Unified.
+	NonQualified string `json:"non_qualified,omitempty"`
 	// Character is the actual emoji character consisting of one or more codepoints.
 	Character string `json:"character"`
+	// Image is the file name of the emoji image.
+	Image string `json:"image"`
 	// SheetX is the X index of the image in the sprite sheet.
 	SheetX int `json:"sheet_x"`
 	// SheetY is the Y index of the image in the sprite sheet.
 	SheetY int `json:"sheet_y"`
-	// AddedIn is the unicode revision that the emoji was added in
+	// AddedIn is the emoji version that the emoji was added in, see Version.
 	AddedIn string `json:"added_in,omitempty"`
-	// PlatformSupport defines the supported platforms.
-	PlatformSupport map[Platform]bool `json:"platform_support,omitempty"`
+	// PlatformSupport is the set of platforms that have art for the emoji.
+	PlatformSupport PlatformSet `json:"platform_support,omitempty"`
 	// Obsoletes is set if the emoji replaces another emoji from an older Unicode revision.
 	Obsoletes string `json:"obsoletes,omitempty"`
 	// ObsoletedBy is set if the emoji is replaced by another emoji from a newer Unicode revision.
@@ -45,18 +61,49 @@ type ImageData struct {
 
 // ImageForModifier returns the ImageData for the given emoji modifier sequence.
 // Currently only skin tone modifications are supported.
+//
+// A single skin tone applies to every person of an emoji that shows several
+// people, and identical skin tones for every person select the single skin
+// tone variation.
 func (i Info) ImageForModifier(mod Modifier) ImageData {
-	switch mod {
-	case SkinToneLight, SkinToneMediumLight, SkinToneMedium, SkinToneMediumDark, SkinToneDark:
-		if modified, ok := i.SkinVariations[mod]; ok {
+	if mod == SkinToneNone {
+		return i.ImageData
+	}
+	if modified, ok := i.SkinVariations[mod]; ok {
+		return modified
+	}
+	tones := mod.Tones()
+	switch {
+	case len(tones) == 1:
+		if modified, ok := i.SkinVariations[SkinTones(mod, mod)]; ok {
+			return modified
+		}
+	case len(tones) > 1 && sameSkinTones(tones):
+		if modified, ok := i.SkinVariations[tones[0]]; ok {
 			return modified
 		}
-	default:
-		break
 	}
 	return i.ImageData
 }
 
+func sameSkinTones(tones []Modifier) bool {
+	for _, tone := range tones {
+		if tone != tones[0] {
+			return false
+		}
+	}
+	return true
+}
+
+// characters returns the character and, if set, the non-qualified character.
+func (d ImageData) characters() []string {
+	characters := []string{d.Character}
+	if nonQualified, ok := parseUnified(d.NonQualified); ok {
+		characters = append(characters, nonQualified)
+	}
+	return characters
+}
+
 // String representation of the modifier sequence.
 // Implements the fmt.Stringer interface.
 func (i Info) String() string {
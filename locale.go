@@ -0,0 +1,67 @@
+package emoji
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+var (
+	localeKeys    []string
+	localeTags    []language.Tag
+	localeMatcher language.Matcher
+	localeOnce    sync.Once
+)
+
+// localizedInfo contains the name and keywords of an emoji in one locale.
+type localizedInfo struct {
+	Name     string
+	Keywords []string
+}
+
+// Locales returns the languages with localized emoji names and keywords.
+func Locales() []language.Tag {
+	initLocales()
+	return append([]language.Tag(nil), localeTags...)
+}
+
+// LocalizedName returns the name of the emoji in the language that best matches the tag.
+// The English FullName is returned if no localized name is available.
+func (i Info) LocalizedName(tag language.Tag) string {
+	if info, ok := localized(tag)[strings.ToLower(i.Unified)]; ok {
+		return info.Name
+	}
+	if len(i.FullName) > 0 {
+		return i.FullName
+	}
+	return i.Name
+}
+
+// localized returns the localized names and keywords of the best matching locale
+// by unified codepoints, or nil if no locale matches the tag.
+func localized(tag language.Tag) map[string]localizedInfo {
+	initLocales()
+	if len(localeTags) == 0 || tag == language.Und {
+		return nil
+	}
+	_, index, confidence := localeMatcher.Match(tag)
+	if confidence == language.No {
+		return nil
+	}
+	return localizedData[localeKeys[index]]
+}
+
+func initLocales() {
+	localeOnce.Do(func() {
+		for key := range localizedData {
+			localeKeys = append(localeKeys, key)
+		}
+		sort.Strings(localeKeys)
+		for _, key := range localeKeys {
+			localeTags = append(localeTags, language.Make(key))
+		}
+		localeMatcher = language.NewMatcher(localeTags)
+	})
+}
@@ -0,0 +1,100 @@
+package emoji
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+// withLocalizedData replaces the localized data for the duration of the test.
+func withLocalizedData(t *testing.T, data map[string]map[string]localizedInfo) {
+	reset := func(data map[string]map[string]localizedInfo) {
+		localizedData = data
+		localeKeys, localeTags, localeMatcher = nil, nil, nil
+		localeOnce = sync.Once{}
+	}
+	previous := localizedData
+	reset(data)
+	t.Cleanup(func() { reset(previous) })
+}
+
+var testLocalizedData = map[string]map[string]localizedInfo{
+	"de": {
+		"1f680": {"Rakete", []string{"weltraum"}},
+		"1f44b": {"winkende Hand", []string{"winken"}},
+	},
+	"ja": {
+		"1f680": {"ロケット", []string{"宇宙"}},
+	},
+}
+
+func TestLocales(t *testing.T) {
+	withLocalizedData(t, testLocalizedData)
+	want := []language.Tag{language.German, language.Japanese}
+	if got := Locales(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Locales() = %v, want %v", got, want)
+	}
+}
+
+func TestInfo_LocalizedName(t *testing.T) {
+	withLocalizedData(t, testLocalizedData)
+	rocket := exactMatch("rocket")
+	tests := []struct {
+		tag  language.Tag
+		want string
+	}{
+		{language.German, "Rakete"},
+		{language.MustParse("de-AT"), "Rakete"},
+		{language.Japanese, "ロケット"},
+		{language.English, rocket.FullName},
+		{language.Spanish, rocket.FullName},
+		{language.Und, rocket.FullName},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag.String(), func(t *testing.T) {
+			if got := rocket.LocalizedName(tt.tag); got != tt.want {
+				t.Errorf("LocalizedName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchLocale(t *testing.T) {
+	withLocalizedData(t, testLocalizedData)
+	tests := []struct {
+		name    string
+		query   string
+		options []SearchOption
+		want    []Info
+	}{
+		{"name", "rakete", []SearchOption{WithLocale(language.German)}, exactMatches("rocket")},
+		{"keyword", "weltraum", []SearchOption{WithLocale(language.German)}, exactMatches("rocket")},
+		{"english", "rocket", []SearchOption{WithLocale(language.German)}, exactMatches("rocket")},
+		{"japanese", "宇宙", []SearchOption{WithLocale(language.Japanese)}, exactMatches("rocket")},
+		{"other locale", "rakete", []SearchOption{WithLocale(language.Japanese)}, []Info{}},
+		{"no locale", "rakete", nil, []Info{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewSearchIndex(tt.options...).Search(tt.query, WithMaxDistance(0))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Search() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchLocale_Dataset(t *testing.T) {
+	if len(Locales()) == 0 {
+		t.Skip("the dataset has no localized data, regenerate it with go generate")
+	}
+	rocket := exactMatch("rocket")
+	for _, info := range NewSearchIndex(WithLocale(language.German)).Search("Rakete") {
+		if info.Name == rocket.Name {
+			return
+		}
+	}
+	t.Errorf("Search() did not match rocket")
+}
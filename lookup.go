@@ -0,0 +1,152 @@
+package emoji
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+var (
+	defaultLookup     *lookupIndex
+	defaultLookupOnce sync.Once
+)
+
+// ByName finds an emoji by its name or one of its alternate names.
+//
+// The name may be written as a shortcode with skin tone suffixes,
+// e.g. :wave::skin-tone-4:, in which case the modifier is returned as well.
+func ByName(name string) (Info, Modifier, bool) {
+	return getDefaultLookup().byName(name)
+}
+
+// ByCharacter finds an emoji by its character, which may be a skin tone variation.
+// Fully-qualified and non-qualified characters are both accepted.
+func ByCharacter(s string) (Info, Modifier, bool) {
+	return getDefaultLookup().byCharacter(s)
+}
+
+// ByUnified finds an emoji by its hyphen separated sequence of hex-encoded
+// codepoints, which may be a skin tone variation such as 1f44b-1f3fd.
+// Fully-qualified and non-qualified sequences are both accepted.
+func ByUnified(hex string) (Info, Modifier, bool) {
+	return getDefaultLookup().byUnified(hex)
+}
+
+func getDefaultLookup() *lookupIndex {
+	defaultLookupOnce.Do(func() {
+		defaultLookup = newLookupIndex(All())
+	})
+	return defaultLookup
+}
+
+// lookupIndex provides exact lookups of emojis.
+type lookupIndex struct {
+	infos []Info
+	// names maps names and alternate names to the index of the emoji.
+	names map[string]int
+	// characters maps characters without emoji presentation selectors
+	// to the matching emoji and modifier.
+	characters map[string]sequenceMatch
+}
+
+func newLookupIndex(infos []Info) *lookupIndex {
+	names := make(map[string]int, len(infos))
+	characters := make(map[string]sequenceMatch, len(infos))
+	addCharacter := func(image ImageData, match sequenceMatch) {
+		for _, s := range image.characters() {
+			key := stripPresentationSelectors(s)
+			if _, exists := characters[key]; !exists && len(key) > 0 {
+				characters[key] = match
+			}
+		}
+	}
+	// canonical names take precedence over alternate names
+	for i, info := range infos {
+		if _, exists := names[info.Name]; !exists {
+			names[info.Name] = i
+		}
+		addCharacter(info.ImageData, sequenceMatch{index: i})
+		for mod, variation := range info.SkinVariations {
+			addCharacter(variation, sequenceMatch{index: i, modifier: mod})
+		}
+	}
+	for i, info := range infos {
+		for _, name := range info.AlternateNames {
+			if _, exists := names[name]; !exists {
+				names[name] = i
+			}
+		}
+	}
+	return &lookupIndex{
+		infos:      infos,
+		names:      names,
+		characters: characters,
+	}
+}
+
+func (l *lookupIndex) byName(name string) (Info, Modifier, bool) {
+	name = strings.TrimSuffix(strings.TrimPrefix(name, ":"), ":")
+	mod := SkinToneNone
+	if sep := strings.Index(name, "::"); sep >= 0 {
+		codes := strings.Split(name[sep+2:], "::")
+		tones := make([]Modifier, len(codes))
+		for i, code := range codes {
+			tone, ok := parseSkinToneCode(code)
+			if !ok {
+				return Info{}, SkinToneNone, false
+			}
+			tones[i] = tone
+		}
+		name, mod = name[:sep], SkinTones(tones...)
+	}
+	idx, ok := l.names[name]
+	if !ok {
+		return Info{}, SkinToneNone, false
+	}
+	info := l.infos[idx]
+	if len(info.SkinVariations) == 0 {
+		mod = SkinToneNone
+	}
+	return info, mod, true
+}
+
+func (l *lookupIndex) byCharacter(s string) (Info, Modifier, bool) {
+	match, ok := l.characters[stripPresentationSelectors(s)]
+	if !ok {
+		return Info{}, SkinToneNone, false
+	}
+	return l.infos[match.index], match.modifier, true
+}
+
+func (l *lookupIndex) byUnified(hex string) (Info, Modifier, bool) {
+	s, ok := parseUnified(hex)
+	if !ok {
+		return Info{}, SkinToneNone, false
+	}
+	return l.byCharacter(s)
+}
+
+// stripPresentationSelectors removes emoji presentation selectors from the text.
+func stripPresentationSelectors(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == emojiPresentationSelector {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// parseUnified returns the string for a hyphen separated sequence
+// of hex-encoded codepoints.
+func parseUnified(hex string) (string, bool) {
+	sb := strings.Builder{}
+	for _, part := range strings.Split(hex, "-") {
+		codepoint, err := strconv.ParseUint(part, 16, 32)
+		if err != nil || !utf8.ValidRune(rune(codepoint)) {
+			return "", false
+		}
+		sb.WriteRune(rune(codepoint))
+	}
+	return sb.String(), true
+}
@@ -0,0 +1,78 @@
+package emoji
+
+import "testing"
+
+type lookupResult struct {
+	name     string
+	modifier Modifier
+	ok       bool
+}
+
+func TestByName(t *testing.T) {
+	tests := []struct {
+		input string
+		want  lookupResult
+	}{
+		{"rocket", lookupResult{"rocket", SkinToneNone, true}},
+		{":rocket:", lookupResult{"rocket", SkinToneNone, true}},
+		{"thumbsup", lookupResult{"_1", SkinToneNone, true}},
+		{":wave::skin-tone-4:", lookupResult{"wave", SkinToneMedium, true}},
+		{"wave::skin-tone-dark", lookupResult{"wave", SkinToneDark, true}},
+		{"wave::skin-tone-9", lookupResult{"", SkinToneNone, false}},
+		{"rocket::skin-tone-4", lookupResult{"rocket", SkinToneNone, true}},
+		{"fubar", lookupResult{"", SkinToneNone, false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			info, mod, ok := ByName(tt.input)
+			if got := (lookupResult{info.Name, mod, ok}); got != tt.want {
+				t.Errorf("ByName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestByCharacter(t *testing.T) {
+	tests := []struct {
+		input string
+		want  lookupResult
+	}{
+		{"🚀", lookupResult{"rocket", SkinToneNone, true}},
+		{"👋🏽", lookupResult{"wave", SkinToneMedium, true}},
+		{"❤️", lookupResult{"heart", SkinToneNone, true}},
+		{"❤", lookupResult{"heart", SkinToneNone, true}},
+		{"#⃣", lookupResult{"hash", SkinToneNone, true}},
+		{"🚀🚀", lookupResult{"", SkinToneNone, false}},
+		{"", lookupResult{"", SkinToneNone, false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			info, mod, ok := ByCharacter(tt.input)
+			if got := (lookupResult{info.Name, mod, ok}); got != tt.want {
+				t.Errorf("ByCharacter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestByUnified(t *testing.T) {
+	tests := []struct {
+		input string
+		want  lookupResult
+	}{
+		{"1f680", lookupResult{"rocket", SkinToneNone, true}},
+		{"1F44B-1F3FD", lookupResult{"wave", SkinToneMedium, true}},
+		{"0023-fe0f-20e3", lookupResult{"hash", SkinToneNone, true}},
+		{"0023-20e3", lookupResult{"hash", SkinToneNone, true}},
+		{"2764", lookupResult{"heart", SkinToneNone, true}},
+		{"zz", lookupResult{"", SkinToneNone, false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			info, mod, ok := ByUnified(tt.input)
+			if got := (lookupResult{info.Name, mod, ok}); got != tt.want {
+				t.Errorf("ByUnified() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,8 +1,14 @@
 package emoji
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // Modifier is a string representation of an emoji modifier sequence.
+//
+// Emojis that show several people, such as 🧑🏻‍🤝‍🧑🏿, can have a skin tone
+// per person. The skin tones are combined into a single modifier with SkinTones.
 type Modifier int
 
 const (
@@ -20,6 +26,28 @@ const (
 	SkinToneDark
 )
 
+// skinToneBits is the number of bits a single skin tone occupies in a Modifier.
+const skinToneBits = 3
+
+// SkinTones combines the skin tones of the people shown by an emoji, in order,
+// into a single modifier. A single skin tone is returned unchanged.
+func SkinTones(tones ...Modifier) Modifier {
+	m := SkinToneNone
+	for i := len(tones) - 1; i >= 0; i-- {
+		m = m<<skinToneBits | tones[i]
+	}
+	return m
+}
+
+// Tones returns the individual skin tones of the modifier in order.
+func (m Modifier) Tones() []Modifier {
+	var tones []Modifier
+	for ; m > 0; m >>= skinToneBits {
+		tones = append(tones, m&(1<<skinToneBits-1))
+	}
+	return tones
+}
+
 // NewModifier creates a modifier from a string.
 // An empty string is interpreted as `SkinToneNone`.
 func NewModifier(text string) (Modifier, error) {
@@ -29,8 +57,29 @@ func NewModifier(text string) (Modifier, error) {
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface.
 // This function also determines how a modifier is unmarshaled from JSON.
+//
+// Multiple skin tones are separated by commas, e.g. "light,dark",
+// or by hyphens in codepoint form, e.g. "1F3FB-1F3FF".
 func (m *Modifier) UnmarshalText(text []byte) error {
-	switch string(text) {
+	parts := strings.FieldsFunc(string(text), func(r rune) bool {
+		return r == ',' || r == '-'
+	})
+	if len(parts) <= 1 {
+		return m.unmarshalSkinTone(string(text))
+	}
+	tones := make([]Modifier, len(parts))
+	for i, part := range parts {
+		if err := tones[i].unmarshalSkinTone(part); err != nil || tones[i] == SkinToneNone {
+			*m = SkinToneNone
+			return fmt.Errorf("unrecognized modifier sequence %s", string(text))
+		}
+	}
+	*m = SkinTones(tones...)
+	return nil
+}
+
+func (m *Modifier) unmarshalSkinTone(text string) error {
+	switch text {
 	case "", "none":
 		*m = SkinToneNone
 	case "1F3FB", "light":
@@ -45,7 +94,7 @@ func (m *Modifier) UnmarshalText(text []byte) error {
 		*m = SkinToneDark
 	default:
 		*m = SkinToneNone
-		return fmt.Errorf("unrecognized modifier sequence %s", string(text))
+		return fmt.Errorf("unrecognized modifier sequence %s", text)
 	}
 	return nil
 }
@@ -57,6 +106,7 @@ func (m Modifier) MarshalText() ([]byte, error) {
 }
 
 // String implements fmt.Stringer and returns a string representation of the modifier.
+// Multiple skin tones are separated by commas.
 func (m Modifier) String() string {
 	switch m {
 	case SkinToneNone:
@@ -71,12 +121,20 @@ func (m Modifier) String() string {
 		return "medium_dark"
 	case SkinToneDark:
 		return "dark"
-	default:
+	}
+	tones := m.Tones()
+	if len(tones) <= 1 {
 		return "unknown"
 	}
+	names := make([]string, len(tones))
+	for i, tone := range tones {
+		names[i] = tone.String()
+	}
+	return strings.Join(names, ",")
 }
 
 // Unicode returns the sequence of unicode runes that represent the modifier.
+// The runes of multiple skin tones are returned in order.
 func (m Modifier) Unicode() []rune {
 	switch m {
 	case SkinToneLight:
@@ -89,7 +147,14 @@ func (m Modifier) Unicode() []rune {
 		return []rune{0x1F3FE}
 	case SkinToneDark:
 		return []rune{0x1F3FF}
-	default:
+	}
+	tones := m.Tones()
+	if len(tones) <= 1 {
 		return nil
 	}
+	var runes []rune
+	for _, tone := range tones {
+		runes = append(runes, tone.Unicode()...)
+	}
+	return runes
 }
@@ -0,0 +1,130 @@
+package emoji
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewModifier(t *testing.T) {
+	tests := []struct {
+		text    string
+		want    Modifier
+		wantErr bool
+	}{
+		{"", SkinToneNone, false},
+		{"medium_dark", SkinToneMediumDark, false},
+		{"1F3FB", SkinToneLight, false},
+		{"light,dark", SkinTones(SkinToneLight, SkinToneDark), false},
+		{"1F3FB-1F3FF", SkinTones(SkinToneLight, SkinToneDark), false},
+		{"dark,dark", SkinTones(SkinToneDark, SkinToneDark), false},
+		{"light,none", SkinToneNone, true},
+		{"purple", SkinToneNone, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, err := NewModifier(tt.text)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewModifier() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NewModifier() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestModifier_Tones(t *testing.T) {
+	tests := []struct {
+		name     string
+		modifier Modifier
+		tones    []Modifier
+		text     string
+		unicode  []rune
+	}{
+		{"none", SkinToneNone, nil, "none", nil},
+		{"single", SkinToneMedium, []Modifier{SkinToneMedium}, "medium", []rune{0x1F3FD}},
+		{
+			"pair",
+			SkinTones(SkinToneMediumLight, SkinToneDark),
+			[]Modifier{SkinToneMediumLight, SkinToneDark},
+			"medium_light,dark",
+			[]rune{0x1F3FC, 0x1F3FF},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.modifier.Tones(); !reflect.DeepEqual(got, tt.tones) {
+				t.Errorf("Tones() = %v, want %v", got, tt.tones)
+			}
+			if got := tt.modifier.String(); got != tt.text {
+				t.Errorf("String() = %v, want %v", got, tt.text)
+			}
+			if got := tt.modifier.Unicode(); !reflect.DeepEqual(got, tt.unicode) {
+				t.Errorf("Unicode() = %v, want %v", got, tt.unicode)
+			}
+		})
+	}
+}
+
+func TestInfo_ImageForModifier(t *testing.T) {
+	holdingHands := Info{
+		Name:      "people_holding_hands",
+		ImageData: ImageData{Character: "🧑‍🤝‍🧑"},
+		SkinVariations: map[Modifier]ImageData{
+			SkinTones(SkinToneLight, SkinToneLight): {Character: "🧑🏻‍🤝‍🧑🏻"},
+			SkinTones(SkinToneLight, SkinToneDark):  {Character: "🧑🏻‍🤝‍🧑🏿"},
+		},
+	}
+	handshake := Info{
+		Name:      "handshake",
+		ImageData: ImageData{Character: "🤝"},
+		SkinVariations: map[Modifier]ImageData{
+			SkinToneLight:                          {Character: "🤝🏻"},
+			SkinTones(SkinToneLight, SkinToneDark): {Character: "🫱🏻‍🫲🏿"},
+		},
+	}
+	tests := []struct {
+		name     string
+		info     Info
+		modifier Modifier
+		want     string
+	}{
+		{"no modifier", holdingHands, SkinToneNone, "🧑‍🤝‍🧑"},
+		{"pair", holdingHands, SkinTones(SkinToneLight, SkinToneDark), "🧑🏻‍🤝‍🧑🏿"},
+		{"single tone for every person", holdingHands, SkinToneLight, "🧑🏻‍🤝‍🧑🏻"},
+		{"missing variation", holdingHands, SkinToneDark, "🧑‍🤝‍🧑"},
+		{"identical pair", handshake, SkinTones(SkinToneLight, SkinToneLight), "🤝🏻"},
+		{"different pair", handshake, SkinTones(SkinToneLight, SkinToneDark), "🫱🏻‍🫲🏿"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.info.ImageForModifier(tt.modifier).Character; got != tt.want {
+				t.Errorf("ImageForModifier() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInfo_ImageForModifierDataset(t *testing.T) {
+	tests := []struct {
+		name     string
+		modifier Modifier
+		want     string
+	}{
+		{"people_holding_hands", SkinTones(SkinToneLight, SkinToneDark), "🧑🏻‍🤝‍🧑🏿"},
+		{"people_holding_hands", SkinToneMedium, "🧑🏽‍🤝‍🧑🏽"},
+		{"handshake", SkinTones(SkinToneDark, SkinToneLight), "🫱🏿‍🫲🏻"},
+		{"couplekiss", SkinTones(SkinToneMediumLight, SkinToneMediumDark), "🧑🏼‍❤️‍💋‍🧑🏾"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, _, ok := ByName(tt.name)
+			if !ok {
+				t.Fatalf("ByName(%q) found no emoji", tt.name)
+			}
+			if got := info.ImageForModifier(tt.modifier).Character; got != tt.want {
+				t.Errorf("ImageForModifier(%v) = %v, want %v", tt.modifier, got, tt.want)
+			}
+		})
+	}
+}
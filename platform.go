@@ -1,6 +1,7 @@
 package emoji
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 )
@@ -65,3 +66,91 @@ func (p Platform) String() string {
 		return "Unknown"
 	}
 }
+
+// platforms are the platforms that a PlatformSet can contain in JSON key order.
+var platforms = []Platform{PlatformApple, PlatformFacebook, PlatformGoogle, PlatformTwitter}
+
+// PlatformSet is a set of platforms stored as a bitmask.
+//
+// It is encoded in JSON as an object that maps every platform to whether
+// it is in the set, e.g. {"Apple":true,"Facebook":false,"Google":true,"Twitter":true}.
+type PlatformSet uint8
+
+// NewPlatformSet returns the set of the platforms. PlatformNone is ignored.
+func NewPlatformSet(platforms ...Platform) PlatformSet {
+	var s PlatformSet
+	for _, p := range platforms {
+		s |= p.bit()
+	}
+	return s
+}
+
+// bit returns the bit of the platform in a PlatformSet.
+func (p Platform) bit() PlatformSet {
+	if p <= PlatformNone || p > PlatformFacebook {
+		return 0
+	}
+	return 1 << p
+}
+
+// Has reports whether the platform is in the set.
+func (s PlatformSet) Has(p Platform) bool {
+	bit := p.bit()
+	return bit != 0 && s&bit == bit
+}
+
+// Union returns the set of platforms that are in either set.
+func (s PlatformSet) Union(other PlatformSet) PlatformSet {
+	return s | other
+}
+
+// Intersect returns the set of platforms that are in both sets.
+func (s PlatformSet) Intersect(other PlatformSet) PlatformSet {
+	return s & other
+}
+
+// Platforms returns the platforms in the set ordered by name.
+func (s PlatformSet) Platforms() []Platform {
+	var result []Platform
+	for _, p := range platforms {
+		if s.Has(p) {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+// String implements fmt.Stringer and returns the comma separated platforms in the set.
+func (s PlatformSet) String() string {
+	names := make([]string, 0, len(platforms))
+	for _, p := range s.Platforms() {
+		names = append(names, p.String())
+	}
+	return strings.Join(names, ",")
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (s PlatformSet) MarshalJSON() ([]byte, error) {
+	support := make(map[Platform]bool, len(platforms))
+	for _, p := range platforms {
+		support[p] = s.Has(p)
+	}
+	return json.Marshal(support)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Unknown platforms are ignored.
+func (s *PlatformSet) UnmarshalJSON(data []byte) error {
+	var support map[string]bool
+	if err := json.Unmarshal(data, &support); err != nil {
+		return err
+	}
+	*s = 0
+	for name, supported := range support {
+		var p Platform
+		if err := p.UnmarshalText([]byte(name)); err == nil && supported {
+			*s |= p.bit()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package emoji
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"reflect"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestPlatformSet(t *testing.T) {
+	s := NewPlatformSet(PlatformTwitter, PlatformApple, PlatformNone)
+	for _, tt := range []struct {
+		platform Platform
+		want     bool
+	}{
+		{PlatformNone, false},
+		{PlatformApple, true},
+		{PlatformGoogle, false},
+		{PlatformTwitter, true},
+		{PlatformFacebook, false},
+		{Platform(42), false},
+	} {
+		if got := s.Has(tt.platform); got != tt.want {
+			t.Errorf("Has(%v) = %v, want %v", tt.platform, got, tt.want)
+		}
+	}
+	union := s.Union(NewPlatformSet(PlatformGoogle))
+	if want := []Platform{PlatformApple, PlatformGoogle, PlatformTwitter}; !reflect.DeepEqual(union.Platforms(), want) {
+		t.Errorf("Union().Platforms() = %v, want %v", union.Platforms(), want)
+	}
+	if got := union.Intersect(NewPlatformSet(PlatformGoogle, PlatformFacebook)); got != NewPlatformSet(PlatformGoogle) {
+		t.Errorf("Intersect() = %v, want Google", got)
+	}
+	if got := s.String(); got != "Apple,Twitter" {
+		t.Errorf("String() = %q, want %q", got, "Apple,Twitter")
+	}
+	if got := NewPlatformSet().Platforms(); got != nil {
+		t.Errorf("Platforms() = %v, want nil", got)
+	}
+}
+
+func TestPlatformSet_JSON(t *testing.T) {
+	s := NewPlatformSet(PlatformApple, PlatformGoogle)
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"Apple":true,"Facebook":false,"Google":true,"Twitter":false}`; string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+	var got PlatformSet
+	if err := json.Unmarshal(data, &got); err != nil || got != s {
+		t.Errorf("Unmarshal() = %v, %v, want %v", got, err, s)
+	}
+	if err := json.Unmarshal([]byte(`{"twitter":true,"Google":false,"Myspace":true}`), &got); err != nil || got != NewPlatformSet(PlatformTwitter) {
+		t.Errorf("Unmarshal() = %v, %v, want Twitter", got, err)
+	}
+	if err := json.Unmarshal([]byte(`["Apple"]`), &got); err == nil {
+		t.Error("Unmarshal() expected error for a list")
+	}
+}
+
+// initTrace matches the GODEBUG=inittrace=1 line of this package.
+var initTrace = regexp.MustCompile(`init github.com/mrosales/emoji-go @\S+ ms, ([0-9.]+) ms clock, ([0-9]+) bytes, ([0-9]+) allocs`)
+
+// BenchmarkInit reports the time and heap allocations of the package
+// initialization, which is dominated by the dataset with the emoji_literal tag,
+// by running the test binary without tests.
+func BenchmarkInit(b *testing.B) {
+	var clock, bytes, allocs float64
+	for i := 0; i < b.N; i++ {
+		cmd := exec.Command(os.Args[0], "-test.run=^$")
+		cmd.Env = append(os.Environ(), "GODEBUG=inittrace=1")
+		output, err := cmd.CombinedOutput()
+		if err != nil {
+			b.Fatalf("failed running %s: %v", os.Args[0], err)
+		}
+		match := initTrace.FindSubmatch(output)
+		if match == nil {
+			b.Fatalf("missing init trace in output: %s", output)
+		}
+		for j, total := range []*float64{&clock, &bytes, &allocs} {
+			value, err := strconv.ParseFloat(string(match[j+1]), 64)
+			if err != nil {
+				b.Fatal(err)
+			}
+			*total += value
+		}
+	}
+	b.ReportMetric(clock*1e6/float64(b.N), "init-ns")
+	b.ReportMetric(bytes/float64(b.N), "init-B")
+	b.ReportMetric(allocs/float64(b.N), "init-allocs")
+}
@@ -0,0 +1,276 @@
+package emoji
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// queryFields maps the field qualifiers of a query to a parser of their value.
+var queryFields = map[string]func(value string) (func(Info) bool, error){
+	"category":    parseCategoryFilter,
+	"subcategory": parseSubcategoryFilter,
+	"version":     parseVersionFilter,
+	"platform":    parsePlatformFilter,
+}
+
+// Query searches the emojis with a query of space separated terms, such as
+// `heart category:symbols version:<13 -broken platform:twitter`.
+//
+// Every word must match a name or keyword of the emoji, and the emojis are ranked
+// by the sum of the Levenshtein distances of the words. A word starting with "-"
+// excludes the emojis whose names or keywords contain it. Double quotes group
+// several words into a single term, e.g. "red heart".
+//
+// The following field qualifiers filter the emojis and can be negated as well:
+//
+//	category:symbols       the category, e.g. smileys or animals_and_nature
+//	subcategory:face-hand  the subcategory
+//	version:<13            the emoji version it was added in, compared with <, <=, >, >= or =
+//	platform:twitter       supported on the platform
+//
+// A query that consists only of qualifiers returns all matching emojis in dataset order.
+// The options are applied as in Search, except for WithRanker.
+func (si *SearchIndex) Query(q string, opts ...SearchOption) ([]Info, error) {
+	options := si.options
+	for _, optionFunc := range opts {
+		optionFunc(&options)
+	}
+	words, err := parseQuery(q, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	k := si.current()
+	// summed distance by index in infos of the emojis that matched every word
+	var distances map[int]int
+	if len(words) == 0 {
+		distances = map[int]int{}
+		for i, info := range k.infos {
+			if options.Filter == nil || options.Filter(info) {
+				distances[i] = 0
+			}
+		}
+	}
+	for n, word := range words {
+		next := map[int]int{}
+		for _, rank := range k.rank(word, options) {
+			if options.MaxDistance > 0 && rank.Distance > options.MaxDistance {
+				continue
+			}
+			idx := k.indexes[rank.OriginalIndex]
+			if n == 0 {
+				next[idx] = rank.Distance
+			} else if distance, ok := distances[idx]; ok {
+				next[idx] = distance + rank.Distance
+			}
+		}
+		distances = next
+	}
+
+	indexes := make([]int, 0, len(distances))
+	for idx := range distances {
+		indexes = append(indexes, idx)
+	}
+	sort.Slice(indexes, func(i, j int) bool {
+		a, b := indexes[i], indexes[j]
+		if distances[a] != distances[b] {
+			return distances[a] < distances[b]
+		}
+		if options.TieBreaker != nil {
+			if options.TieBreaker(k.infos[a], k.infos[b]) {
+				return true
+			}
+			if options.TieBreaker(k.infos[b], k.infos[a]) {
+				return false
+			}
+		}
+		return a < b
+	})
+	if options.Limit > 0 && len(indexes) > options.Limit {
+		indexes = indexes[:options.Limit]
+	}
+	infos := make([]Info, len(indexes))
+	for i, idx := range indexes {
+		infos[i] = k.infos[idx]
+	}
+	return infos, nil
+}
+
+// queryTerm is a single term of a query.
+type queryTerm struct {
+	text    string
+	negated bool
+	quoted  bool
+}
+
+// parseQuery adds the filters of the query to the options
+// and returns the words that have to match.
+func parseQuery(q string, options *searchOptionSet) ([]string, error) {
+	var (
+		words    []string
+		excluded []string
+	)
+	for _, term := range splitQuery(q) {
+		if !term.quoted {
+			if sep := strings.IndexByte(term.text, ':'); sep > 0 {
+				if parse, ok := queryFields[strings.ToLower(term.text[:sep])]; ok {
+					filter, err := parse(term.text[sep+1:])
+					if err != nil {
+						return nil, fmt.Errorf("invalid query term %s: %w", term.text, err)
+					}
+					if term.negated {
+						filter = negateFilter(filter)
+					}
+					WithFilter(filter)(options)
+					continue
+				}
+			}
+			// shortcodes are matched by their name
+			term.text = strings.Trim(term.text, ":")
+		}
+		word := strings.ToLower(term.text)
+		switch {
+		case len(word) == 0:
+		case term.negated:
+			excluded = append(excluded, word)
+		default:
+			words = append(words, word)
+		}
+	}
+	if len(excluded) > 0 {
+		WithFilter(func(info Info) bool {
+			for _, word := range excluded {
+				if containsWord(info, word) {
+					return false
+				}
+			}
+			return true
+		})(options)
+	}
+	return words, nil
+}
+
+// splitQuery splits the query into terms separated by spaces.
+// Double quotes group several words into one term, and a term
+// that starts with "-" outside of quotes is negated.
+func splitQuery(q string) []queryTerm {
+	var (
+		terms   []queryTerm
+		term    queryTerm
+		sb      strings.Builder
+		started bool
+		quoted  bool
+	)
+	flush := func() {
+		if term.text = sb.String(); len(term.text) > 0 {
+			terms = append(terms, term)
+		}
+		term, started = queryTerm{}, false
+		sb.Reset()
+	}
+	for _, r := range q {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			term.quoted, started = true, true
+		case unicode.IsSpace(r) && !quoted:
+			flush()
+		case r == '-' && !started:
+			term.negated, started = true, true
+		default:
+			sb.WriteRune(r)
+			started = true
+		}
+	}
+	flush()
+	return terms
+}
+
+// containsWord reports whether a name or keyword of the emoji contains the word.
+func containsWord(info Info, word string) bool {
+	if strings.Contains(strings.ToLower(info.Name), word) ||
+		strings.Contains(strings.ToLower(info.FullName), word) {
+		return true
+	}
+	for _, keywords := range [][]string{info.AlternateNames, info.Keywords} {
+		for _, keyword := range keywords {
+			if strings.Contains(strings.ToLower(keyword), word) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func negateFilter(filter func(Info) bool) func(Info) bool {
+	return func(info Info) bool {
+		return !filter(info)
+	}
+}
+
+func parseCategoryFilter(value string) (func(Info) bool, error) {
+	value = normalizeCategory(value)
+	if len(value) == 0 {
+		return nil, fmt.Errorf("empty category")
+	}
+	return func(info Info) bool {
+		return strings.Contains(normalizeCategory(string(info.Category)), value)
+	}, nil
+}
+
+func parseSubcategoryFilter(value string) (func(Info) bool, error) {
+	value = normalizeCategory(value)
+	if len(value) == 0 {
+		return nil, fmt.Errorf("empty subcategory")
+	}
+	return func(info Info) bool {
+		return strings.Contains(normalizeCategory(string(info.Subcategory)), value)
+	}, nil
+}
+
+// normalizeCategory lowercases the category and removes separators,
+// so that "animals_and_nature" matches "Animals & Nature".
+func normalizeCategory(s string) string {
+	s = strings.ReplaceAll(strings.ToLower(s), "&", "and")
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+func parseVersionFilter(value string) (func(Info) bool, error) {
+	var accept func(cmp int) bool
+	switch {
+	case strings.HasPrefix(value, "<="):
+		value, accept = value[2:], func(cmp int) bool { return cmp <= 0 }
+	case strings.HasPrefix(value, ">="):
+		value, accept = value[2:], func(cmp int) bool { return cmp >= 0 }
+	case strings.HasPrefix(value, "<"):
+		value, accept = value[1:], func(cmp int) bool { return cmp < 0 }
+	case strings.HasPrefix(value, ">"):
+		value, accept = value[1:], func(cmp int) bool { return cmp > 0 }
+	default:
+		value, accept = strings.TrimPrefix(value, "="), func(cmp int) bool { return cmp == 0 }
+	}
+	version, err := ParseVersion(value)
+	if err != nil {
+		return nil, err
+	}
+	return func(info Info) bool {
+		return accept(info.Version().Compare(version))
+	}, nil
+}
+
+func parsePlatformFilter(value string) (func(Info) bool, error) {
+	var platform Platform
+	if err := platform.UnmarshalText([]byte(value)); err != nil {
+		return nil, err
+	}
+	return func(info Info) bool {
+		return info.PlatformSupport.Has(platform)
+	}, nil
+}
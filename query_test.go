@@ -0,0 +1,122 @@
+package emoji
+
+import (
+	"reflect"
+	"testing"
+)
+
+var queryDataset = []Info{
+	{
+		Name: "heart", Category: CategorySmileysAndEmotion, AlternateNames: []string{"heart"}, Keywords: []string{"red heart", "love"},
+		ImageData: ImageData{AddedIn: "0.6", PlatformSupport: NewPlatformSet(PlatformApple, PlatformTwitter)},
+	},
+	{
+		Name: "broken_heart", Category: CategorySmileysAndEmotion, AlternateNames: []string{"broken_heart"}, Keywords: []string{"break"},
+		ImageData: ImageData{AddedIn: "0.6", PlatformSupport: NewPlatformSet(PlatformApple, PlatformTwitter)},
+	},
+	{
+		Name: "heart_on_fire", Category: CategorySmileysAndEmotion, AlternateNames: []string{"heart_on_fire"}, Keywords: []string{"burn", "love"},
+		ImageData: ImageData{AddedIn: "13.1", PlatformSupport: NewPlatformSet(PlatformApple)},
+	},
+	{
+		Name: "hearts", Category: CategoryActivities, AlternateNames: []string{"hearts"}, Keywords: []string{"card", "game"},
+		ImageData: ImageData{AddedIn: "0.6", PlatformSupport: NewPlatformSet(PlatformTwitter)},
+	},
+	{
+		Name: "cat", Category: CategoryAnimalsAndNature, AlternateNames: []string{"cat"}, Keywords: []string{"pet"},
+		ImageData: ImageData{AddedIn: "0.7", PlatformSupport: NewPlatformSet(PlatformTwitter)},
+	},
+}
+
+func TestSearchIndex_Query(t *testing.T) {
+	tests := []struct {
+		query   string
+		want    []string
+		wantErr bool
+	}{
+		{"heart", []string{"heart", "hearts", "broken_heart", "heart_on_fire"}, false},
+		{"heart love", []string{"heart", "heart_on_fire"}, false},
+		{"heart -broken", []string{"heart", "hearts", "heart_on_fire"}, false},
+		{"heart -love -card", []string{"broken_heart"}, false},
+		{`"red heart"`, []string{"heart"}, false},
+		{":heart:", []string{"heart", "hearts", "broken_heart", "heart_on_fire"}, false},
+		{"heart category:smileys", []string{"heart", "broken_heart", "heart_on_fire"}, false},
+		{"heart -category:smileys_and_emotion", []string{"hearts"}, false},
+		{"category:animals_and_nature", []string{"cat"}, false},
+		{"version:<13", []string{"heart", "broken_heart", "hearts", "cat"}, false},
+		{"version:>=13", []string{"heart_on_fire"}, false},
+		{"version:0.7", []string{"cat"}, false},
+		{"heart platform:apple -platform:twitter", []string{"heart_on_fire"}, false},
+		{"heart category:smileys version:<13 -broken platform:twitter", []string{"heart"}, false},
+		{"dog", []string{}, false},
+		{"version:<x", nil, true},
+		{"platform:myspace", nil, true},
+	}
+	index := NewSearchIndex(WithDataset(queryDataset), WithMaxDistance(10))
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			infos, err := index.Query(tt.query)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Query() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var got []string
+			if infos != nil {
+				got = []string{}
+			}
+			for _, info := range infos {
+				got = append(got, info.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Query() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchIndex_QueryLimit(t *testing.T) {
+	index := NewSearchIndex(WithDataset(queryDataset))
+	got, err := index.Query("heart -broken", WithLimit(2))
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	if want := []Info{queryDataset[0], queryDataset[3]}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Query() = %v, want %v", got, want)
+	}
+}
+
+func TestSearchIndex_QueryDataset(t *testing.T) {
+	heart := exactMatch("heart")
+	if len(heart.Keywords) == 0 {
+		t.Skip("the dataset has no CLDR keywords, regenerate it with go generate")
+	}
+	got, err := NewSearchIndex().Query("red heart")
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	for _, info := range got {
+		if info.Name == heart.Name {
+			return
+		}
+	}
+	t.Errorf("Query() = %v, want it to contain %v", got, heart)
+}
+
+func Test_splitQuery(t *testing.T) {
+	tests := []struct {
+		query string
+		want  []queryTerm
+	}{
+		{"  red  heart ", []queryTerm{{"red", false, false}, {"heart", false, false}}},
+		{`"red heart" -cat`, []queryTerm{{"red heart", false, true}, {"cat", true, false}}},
+		{`-"broken heart" "-1"`, []queryTerm{{"broken heart", true, true}, {"-1", false, true}}},
+		{"- --x", []queryTerm{{"-x", true, false}}},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			if got := splitQuery(tt.query); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitQuery() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
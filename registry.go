@@ -0,0 +1,165 @@
+package emoji
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// CategoryCustom is the category of custom emojis that have no category.
+const CategoryCustom Category = "Custom"
+
+// Registry holds the built-in emojis of All along with custom emojis,
+// such as team-defined images like :partyparrot:.
+//
+// Custom emojis are image-only: they have a name and an image URL or path
+// but no Unicode character. A Registry is safe for concurrent use, and the
+// Emojizers and SearchIndexes created from it include emojis that are added
+// or removed later.
+type Registry struct {
+	mu sync.RWMutex
+	// infos contains All followed by the custom emojis. It is replaced
+	// rather than modified so that snapshots can be shared.
+	infos []Info
+	// version is incremented whenever an emoji is added or removed.
+	version uint64
+	// lookup is the lookup index of the current version.
+	lookup        *lookupIndex
+	lookupVersion uint64
+}
+
+// NewRegistry creates a registry that contains the built-in emojis.
+func NewRegistry() *Registry {
+	builtin := All()
+	return &Registry{
+		infos:   builtin[:len(builtin):len(builtin)],
+		version: 1,
+	}
+}
+
+// Add registers a custom emoji. The emoji needs a name that is not used
+// by another emoji and an image, and must not have a character.
+// The name is added to the alternate names if it is missing.
+func (r *Registry) Add(info Info) error {
+	if len(info.Name) == 0 {
+		return errors.New("custom emoji has no name")
+	}
+	if len(info.Image) == 0 {
+		return fmt.Errorf("custom emoji %s has no image", info.Name)
+	}
+	if len(info.Character) > 0 || len(info.Unified) > 0 {
+		return fmt.Errorf("custom emoji %s must not have a character", info.Name)
+	}
+	if !containsString(info.AlternateNames, info.Name) {
+		info.AlternateNames = append([]string{info.Name}, info.AlternateNames...)
+	}
+	if len(info.Category) == 0 {
+		info.Category = CategoryCustom
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, existing := range r.infos {
+		for _, name := range info.AlternateNames {
+			if existing.Name == name || containsString(existing.AlternateNames, name) {
+				return fmt.Errorf("emoji name %s is already registered", name)
+			}
+		}
+	}
+	infos := make([]Info, len(r.infos), len(r.infos)+1)
+	copy(infos, r.infos)
+	r.infos = append(infos, info)
+	r.version++
+	return nil
+}
+
+// Remove unregisters the custom emoji with the name and reports whether it existed.
+// Built-in emojis cannot be removed.
+func (r *Registry) Remove(name string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := len(All()); i < len(r.infos); i++ {
+		if r.infos[i].Name != name {
+			continue
+		}
+		infos := make([]Info, 0, len(r.infos)-1)
+		infos = append(infos, r.infos[:i]...)
+		r.infos = append(infos, r.infos[i+1:]...)
+		r.version++
+		return true
+	}
+	return false
+}
+
+// All returns the built-in emojis followed by the custom emojis.
+func (r *Registry) All() []Info {
+	infos, _ := r.snapshot()
+	return append([]Info(nil), infos...)
+}
+
+// Custom returns the custom emojis in the order they were added.
+func (r *Registry) Custom() []Info {
+	infos, _ := r.snapshot()
+	return append([]Info(nil), infos[len(All()):]...)
+}
+
+// ByName finds a built-in or custom emoji by its name or one of its alternate names.
+// The name may be written as a shortcode with skin tone suffixes as in ByName.
+func (r *Registry) ByName(name string) (Info, Modifier, bool) {
+	return r.lookupIndex().byName(name)
+}
+
+// NewEmojizer creates an Emojizer that converts the shortcodes of
+// the built-in and custom emojis. See WithCustomEmojiFunc for how
+// custom emojis are written.
+func (r *Registry) NewEmojizer(opts ...EmojizerOption) *Emojizer {
+	e := NewEmojizer(opts...)
+	e.registry = r
+	return e
+}
+
+// NewSearchIndex creates a SearchIndex of the built-in and custom emojis.
+func (r *Registry) NewSearchIndex(opts ...SearchOption) *SearchIndex {
+	si := NewSearchIndex(opts...)
+	si.registry = r
+	return si
+}
+
+// snapshot returns the current emojis, which must not be modified,
+// along with their version.
+func (r *Registry) snapshot() ([]Info, uint64) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.infos, r.version
+}
+
+func (r *Registry) lookupIndex() *lookupIndex {
+	r.mu.RLock()
+	lookup, current := r.lookup, r.lookupVersion == r.version
+	r.mu.RUnlock()
+	if current {
+		return lookup
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.lookupVersion != r.version {
+		r.lookup = newLookupIndex(r.infos)
+		r.lookupVersion = r.version
+	}
+	return r.lookup
+}
+
+// IsCustom reports whether the emoji is a custom image-only emoji without a character.
+func (i Info) IsCustom() bool {
+	return len(i.Character) == 0
+}
+
+func containsString(values []string, s string) bool {
+	for _, value := range values {
+		if value == s {
+			return true
+		}
+	}
+	return false
+}
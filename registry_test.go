@@ -0,0 +1,153 @@
+package emoji
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func partyParrot() Info {
+	return Info{
+		Name:           "partyparrot",
+		AlternateNames: []string{"party_parrot"},
+		ImageData:      ImageData{Image: "https://example.com/partyparrot.gif"},
+	}
+}
+
+func TestRegistry_Add(t *testing.T) {
+	tests := []struct {
+		name    string
+		info    Info
+		wantErr bool
+	}{
+		{"custom", partyParrot(), false},
+		{"no name", Info{ImageData: ImageData{Image: "a.png"}}, true},
+		{"no image", Info{Name: "a"}, true},
+		{"character", Info{Name: "a", ImageData: ImageData{Image: "a.png", Character: "🅰️"}}, true},
+		{"built-in name", Info{Name: "rocket", ImageData: ImageData{Image: "rocket.png"}}, true},
+		{"built-in alternate name", Info{Name: "a", AlternateNames: []string{"thumbsup"}, ImageData: ImageData{Image: "a.png"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := NewRegistry().Add(tt.info); (err != nil) != tt.wantErr {
+				t.Errorf("Add() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRegistry_Remove(t *testing.T) {
+	r := NewRegistry()
+	if err := r.Add(partyParrot()); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if err := r.Add(partyParrot()); err == nil {
+		t.Errorf("Add() of a duplicate succeeded")
+	}
+	if got := len(r.Custom()); got != 1 {
+		t.Errorf("Custom() returned %d emojis, want 1", got)
+	}
+	if !r.Remove("partyparrot") {
+		t.Errorf("Remove() = false, want true")
+	}
+	if r.Remove("partyparrot") || r.Remove("rocket") {
+		t.Errorf("Remove() = true, want false")
+	}
+	if got := len(r.All()); got != len(All()) {
+		t.Errorf("All() returned %d emojis, want %d", got, len(All()))
+	}
+}
+
+func TestRegistry_ByName(t *testing.T) {
+	r := NewRegistry()
+	if err := r.Add(partyParrot()); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	tests := []struct {
+		input string
+		want  lookupResult
+	}{
+		{":partyparrot:", lookupResult{"partyparrot", SkinToneNone, true}},
+		{"party_parrot", lookupResult{"partyparrot", SkinToneNone, true}},
+		{":wave::skin-tone-4:", lookupResult{"wave", SkinToneMedium, true}},
+		{"fubar", lookupResult{"", SkinToneNone, false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			info, mod, ok := r.ByName(tt.input)
+			if got := (lookupResult{info.Name, mod, ok}); got != tt.want {
+				t.Errorf("ByName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegistry_NewEmojizer(t *testing.T) {
+	r := NewRegistry()
+	img := r.NewEmojizer(WithCustomEmojiFunc(func(info Info) string {
+		return fmt.Sprintf(`<img alt=":%s:" src="%s">`, info.Name, info.Image)
+	}))
+	plain := r.NewEmojizer()
+
+	input := ":partyparrot: :rocket:"
+	if got, want := img.Emojize(input), ":partyparrot: 🚀"; got != want {
+		t.Errorf("Emojize() before Add = %q, want %q", got, want)
+	}
+	if err := r.Add(partyParrot()); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	want := `<img alt=":partyparrot:" src="https://example.com/partyparrot.gif"> 🚀`
+	if got := img.Emojize(input); got != want {
+		t.Errorf("Emojize() = %q, want %q", got, want)
+	}
+	if got, want := plain.Emojize(input), ":partyparrot: 🚀"; got != want {
+		t.Errorf("Emojize() without CustomEmojiFunc = %q, want %q", got, want)
+	}
+}
+
+func TestRegistry_NewSearchIndex(t *testing.T) {
+	r := NewRegistry()
+	index := r.NewSearchIndex(WithMaxDistance(0))
+	if got := index.Search("partyparrot"); len(got) != 0 {
+		t.Errorf("Search() before Add = %v, want no results", got)
+	}
+	if err := r.Add(partyParrot()); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if got, want := index.Search("partyparrot"), r.Custom(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Search() = %v, want %v", got, want)
+	}
+	if got, want := index.Search("party", WithCategory(CategoryCustom)), r.Custom(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Search() = %v, want %v", got, want)
+	}
+	r.Remove("partyparrot")
+	if got := index.Search("partyparrot"); len(got) != 0 {
+		t.Errorf("Search() after Remove = %v, want no results", got)
+	}
+}
+
+func TestRegistry_Concurrent(t *testing.T) {
+	r := NewRegistry()
+	e := r.NewEmojizer()
+	index := r.NewSearchIndex(WithLimit(1))
+	wg := sync.WaitGroup{}
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			name := fmt.Sprintf("custom%d", i)
+			for j := 0; j < 10; j++ {
+				_ = r.Add(Info{Name: name, ImageData: ImageData{Image: name + ".png"}})
+				e.Emojize(":" + name + ":")
+				index.Search(name)
+				r.ByName(name)
+				r.Remove(name)
+			}
+		}(i)
+	}
+	wg.Wait()
+	if got := len(r.Custom()); got != 0 {
+		t.Errorf("Custom() returned %d emojis, want 0", got)
+	}
+}
@@ -0,0 +1,66 @@
+package emoji
+
+// FallbackPolicy returns the replacement for an emoji that the platform cannot display,
+// or false if the policy has no replacement for the emoji.
+type FallbackPolicy func(m Match, platform Platform) (string, bool)
+
+// RenderFor returns a copy of the text where every emoji that the platform
+// has no art for is replaced by the fallback policy, e.g. FallbackPlainText.
+// Emojis that the policy has no replacement for, or every unsupported emoji
+// if the policy is nil, are replaced by their shortcode.
+//
+// PlatformNone supports every emoji, so the text is returned unchanged.
+func RenderFor(text string, platform Platform, policy FallbackPolicy) string {
+	if platform == PlatformNone {
+		return text
+	}
+	return ReplaceFunc(text, func(m Match) string {
+		if m.ImageData().PlatformSupport.Has(platform) {
+			return text[m.Start:m.End]
+		}
+		if policy != nil {
+			if replacement, ok := policy(m, platform); ok {
+				return replacement
+			}
+		}
+		replacement, _ := FallbackShortcode(m, platform)
+		return replacement
+	})
+}
+
+// Fallbacks returns a FallbackPolicy that uses the replacement of the first policy
+// that has one, e.g. Fallbacks(FallbackBaseEmoji, FallbackPlainText).
+func Fallbacks(policies ...FallbackPolicy) FallbackPolicy {
+	return func(m Match, platform Platform) (string, bool) {
+		for _, policy := range policies {
+			if replacement, ok := policy(m, platform); ok {
+				return replacement, true
+			}
+		}
+		return "", false
+	}
+}
+
+// FallbackBaseEmoji is a FallbackPolicy that replaces a skin tone variation
+// with the emoji without skin tone if the platform supports it.
+func FallbackBaseEmoji(m Match, platform Platform) (string, bool) {
+	if m.Modifier == SkinToneNone || !m.Info.PlatformSupport.Has(platform) {
+		return "", false
+	}
+	return m.Info.Character, true
+}
+
+// FallbackPlainText is a FallbackPolicy that replaces an emoji with
+// its plaintext representation, such as <3 for ❤️, if it has one.
+func FallbackPlainText(m Match, platform Platform) (string, bool) {
+	if len(m.Info.PlainText) == 0 {
+		return "", false
+	}
+	return m.Info.PlainText, true
+}
+
+// FallbackShortcode is a FallbackPolicy that replaces an emoji with
+// its shortcode, e.g. :wave::skin-tone-medium:.
+func FallbackShortcode(m Match, platform Platform) (string, bool) {
+	return getDefaultEmojizer().Demojize(m.ImageData().Character), true
+}
@@ -0,0 +1,72 @@
+package emoji
+
+import "testing"
+
+func TestRenderFor(t *testing.T) {
+	custom := func(m Match, platform Platform) (string, bool) {
+		return "[" + m.Info.Name + " on " + platform.String() + "]", true
+	}
+	tests := []struct {
+		name     string
+		text     string
+		platform Platform
+		policy   FallbackPolicy
+		want     string
+	}{
+		{"supported", "🚀 #️⃣", PlatformApple, FallbackPlainText, "🚀 #️⃣"},
+		{"no platform", "🚀 #️⃣", PlatformNone, nil, "🚀 #️⃣"},
+		{"nil policy", "🚀 #️⃣", PlatformFacebook, nil, "🚀 :hash:"},
+		{"policy without replacement", "🚀 #️⃣", PlatformFacebook, FallbackPlainText, "🚀 :hash:"},
+		{"custom", "🚀 #️⃣", PlatformFacebook, custom, "🚀 [hash on Facebook]"},
+		{"fallbacks", "1️⃣ 2️⃣", PlatformFacebook, Fallbacks(FallbackPlainText, custom), "[one on Facebook] [two on Facebook]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RenderFor(tt.text, tt.platform, tt.policy); got != tt.want {
+				t.Errorf("RenderFor() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFallbackPolicies(t *testing.T) {
+	find := func(s string) Match {
+		matches := FindAll(s)
+		if len(matches) != 1 {
+			t.Fatalf("FindAll(%q) returned %d matches", s, len(matches))
+		}
+		return matches[0]
+	}
+	noFacebook := func(m Match) Match {
+		image := m.ImageData()
+		image.PlatformSupport = NewPlatformSet(PlatformApple)
+		m.Info.SkinVariations = map[Modifier]ImageData{m.Modifier: image}
+		return m
+	}
+	tests := []struct {
+		name   string
+		match  Match
+		policy FallbackPolicy
+		want   string
+		wantOk bool
+	}{
+		{"base emoji", noFacebook(find("👋🏽")), FallbackBaseEmoji, "👋", true},
+		{"base emoji without skin tone", find("🚀"), FallbackBaseEmoji, "", false},
+		{"plain text", find("❤️"), FallbackPlainText, "<3", true},
+		{"no plain text", find("🚀"), FallbackPlainText, "", false},
+		{"shortcode", find("👋🏽"), FallbackShortcode, ":wave::skin-tone-medium:", true},
+		{"fallbacks", find("❤️"), Fallbacks(FallbackBaseEmoji, FallbackPlainText, FallbackShortcode), "<3", true},
+		{"no fallbacks", find("❤️"), Fallbacks(), "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.policy(tt.match, PlatformFacebook)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("policy() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+	if got := RenderFor("👋🏽", PlatformFacebook, FallbackBaseEmoji); got != "👋🏽" {
+		t.Errorf("RenderFor() = %q, want the supported skin tone variation", got)
+	}
+}
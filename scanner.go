@@ -0,0 +1,119 @@
+package emoji
+
+import (
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+var (
+	defaultScanner     *Scanner
+	defaultScannerOnce sync.Once
+)
+
+// FindAll returns every emoji in the text using the default Scanner.
+func FindAll(text string) []Match {
+	return getDefaultScanner().FindAll(text)
+}
+
+// ReplaceFunc returns a copy of the text with every emoji replaced by the
+// return value of fn using the default Scanner.
+func ReplaceFunc(text string, fn func(Match) string) string {
+	return getDefaultScanner().ReplaceFunc(text, fn)
+}
+
+// Strip returns a copy of the text with every emoji removed using the default Scanner.
+func Strip(text string) string {
+	return getDefaultScanner().Strip(text)
+}
+
+func getDefaultScanner() *Scanner {
+	defaultScannerOnce.Do(func() {
+		defaultScanner = NewScanner()
+	})
+	return defaultScanner
+}
+
+// Match is an emoji that was found in text.
+type Match struct {
+	// Start is the byte offset of the first byte of the emoji.
+	Start int `json:"start"`
+	// End is the byte offset directly after the last byte of the emoji.
+	End int `json:"end"`
+	// Info is the matched emoji.
+	Info Info `json:"info"`
+	// Modifier is the modifier of the matched variation.
+	Modifier Modifier `json:"modifier"`
+}
+
+// ImageData returns the image data for the matched variation.
+func (m Match) ImageData() ImageData {
+	return m.Info.ImageForModifier(m.Modifier)
+}
+
+// Scanner finds emojis in text.
+//
+// The longest known sequence is matched at every position, so zero-width-joiner
+// sequences, keycaps and flags are reported as a single emoji. Sequences are
+// matched with or without emoji presentation selectors.
+type Scanner struct {
+	sequences *sequenceNode
+}
+
+// NewScanner creates a Scanner for the emoji dataset.
+func NewScanner() *Scanner {
+	sequences, _ := newSequenceTrie(All())
+	return &Scanner{
+		sequences: sequences,
+	}
+}
+
+// FindAll returns every emoji in the text in the order they appear.
+func (s *Scanner) FindAll(text string) []Match {
+	var matches []Match
+	s.scan(text, func(m Match) {
+		matches = append(matches, m)
+	})
+	return matches
+}
+
+// ReplaceFunc returns a copy of the text with every emoji replaced by the
+// return value of fn. The match passed to fn describes the emoji and its modifier.
+func (s *Scanner) ReplaceFunc(text string, fn func(Match) string) string {
+	sb := strings.Builder{}
+	sb.Grow(len(text))
+	last := 0
+	s.scan(text, func(m Match) {
+		sb.WriteString(text[last:m.Start])
+		sb.WriteString(fn(m))
+		last = m.End
+	})
+	sb.WriteString(text[last:])
+	return sb.String()
+}
+
+// Strip returns a copy of the text with every emoji removed.
+func (s *Scanner) Strip(text string) string {
+	return s.ReplaceFunc(text, func(Match) string {
+		return ""
+	})
+}
+
+// scan calls fn for every emoji in the text.
+func (s *Scanner) scan(text string, fn func(Match)) {
+	for i := 0; i < len(text); {
+		match, n, ok := s.sequences.longestMatch(text[i:])
+		if !ok {
+			_, size := utf8.DecodeRuneInString(text[i:])
+			i += size
+			continue
+		}
+		fn(Match{
+			Start:    i,
+			End:      i + n,
+			Info:     All()[match.index],
+			Modifier: match.modifier,
+		})
+		i += n
+	}
+}
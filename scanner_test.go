@@ -0,0 +1,133 @@
+package emoji
+
+import "testing"
+
+func TestFindAll(t *testing.T) {
+	type found struct {
+		start    int
+		end      int
+		name     string
+		modifier Modifier
+	}
+	tests := []struct {
+		name string
+		text string
+		want []found
+	}{
+		{
+			"single emoji",
+			"go 🚀!",
+			[]found{{3, 7, "rocket", SkinToneNone}},
+		},
+		{
+			"skin tone variation",
+			"👋🏽",
+			[]found{{0, 8, "wave", SkinToneMedium}},
+		},
+		{
+			"zero width joiner sequence",
+			"👨‍👩‍👧",
+			[]found{{0, 18, "man_woman_girl", SkinToneNone}},
+		},
+		{
+			"keycap",
+			"#️⃣ tag",
+			[]found{{0, 7, "hash", SkinToneNone}},
+		},
+		{
+			"non-qualified keycap",
+			"#⃣",
+			[]found{{0, 4, "hash", SkinToneNone}},
+		},
+		{
+			"flags",
+			"🇺🇸🇫🇷",
+			[]found{{0, 8, "us", SkinToneNone}, {8, 16, "fr", SkinToneNone}},
+		},
+		{
+			"non-qualified character",
+			"I ❤ go",
+			[]found{{2, 5, "heart", SkinToneNone}},
+		},
+		{
+			"fully-qualified character",
+			"I ❤️ go",
+			[]found{{2, 8, "heart", SkinToneNone}},
+		},
+		{
+			"text presentation",
+			"I ❤︎ go",
+			nil,
+		},
+		{
+			"no emoji",
+			"# 1 plain text",
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := FindAll(tt.text)
+			if len(matches) != len(tt.want) {
+				t.Fatalf("FindAll() = %v, want %v", matches, tt.want)
+			}
+			for i, m := range matches {
+				got := found{m.Start, m.End, m.Info.Name, m.Modifier}
+				if got != tt.want[i] {
+					t.Errorf("FindAll()[%d] = %v, want %v", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestReplaceFunc(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		fn   func(Match) string
+		want string
+	}{
+		{
+			"plain text",
+			"I ❤️ 😃",
+			func(m Match) string {
+				if m.Info.PlainText != "" {
+					return m.Info.PlainText
+				}
+				return m.Info.Name
+			},
+			"I <3 :)",
+		},
+		{
+			"bracketed name with modifier",
+			"🚀 👋🏿",
+			func(m Match) string {
+				if m.Modifier != SkinToneNone {
+					return "[" + m.Info.Name + " " + m.Modifier.String() + "]"
+				}
+				return "[" + m.Info.Name + "]"
+			},
+			"[rocket] [wave dark]",
+		},
+		{
+			"no emoji",
+			"plain text",
+			func(Match) string { return "x" },
+			"plain text",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ReplaceFunc(tt.text, tt.fn); got != tt.want {
+				t.Errorf("ReplaceFunc() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStrip(t *testing.T) {
+	if got, want := Strip("launch 🚀 now 👨‍👩‍👧!"), "launch  now !"; got != want {
+		t.Errorf("Strip() = %q, want %q", got, want)
+	}
+}
@@ -2,43 +2,101 @@ package emoji
 
 import (
 	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
 
 	"github.com/lithammer/fuzzysearch/fuzzy"
+	"golang.org/x/text/language"
 )
 
+// keywordPenalty is added to the distance of descriptive keywords
+// so that they rank below names that match equally well.
+const keywordPenalty = 1
+
 // SearchIndex is allows a keyword-based search of the emoji dataset.
+// Indexes of different datasets are independent of each other.
 type SearchIndex struct {
 	options searchOptionSet
-	// keyword from All array
-	keywordStrings []string
-	// index in All of the keyword.
-	keywordIndexes []int
+	// registry provides the emojis if the index was created from a Registry.
+	registry *Registry
+	// mu guards the keywords, which are rebuilt when the registry changes.
+	mu       sync.Mutex
+	keywords *searchKeywords
+	version  uint64
+}
+
+// searchKeywords contains the searchable keywords of the emojis.
+type searchKeywords struct {
+	infos []Info
+	// keyword from infos array
+	strings []string
+	// index in infos of the keyword.
+	indexes []int
+	// penalty added to the distance of the keyword.
+	penalties []int
 }
 
 // NewSearchIndex creates a keyword fuzzy search index.
 func NewSearchIndex(opts ...SearchOption) *SearchIndex {
-	var (
-		options searchOptionSet
-		// keyword from All array
-		keywordStrings []string
-		// index in All of the keyword.
-		keywordIndexes []int
-	)
-
+	var options searchOptionSet
 	for _, optionFunc := range opts {
 		optionFunc(&options)
 	}
-	for i, info := range All {
+	dataset := options.Dataset
+	if dataset == nil {
+		dataset = All()
+	}
+	return &SearchIndex{
+		options:  options,
+		keywords: newSearchKeywords(dataset, options.Locale),
+	}
+}
+
+func newSearchKeywords(infos []Info, locale language.Tag) *searchKeywords {
+	k := &searchKeywords{infos: infos}
+	for i, info := range infos {
 		for _, term := range info.AlternateNames {
-			keywordStrings = append(keywordStrings, term)
-			keywordIndexes = append(keywordIndexes, i)
+			k.add(term, i, 0)
+		}
+		for _, term := range info.Keywords {
+			k.add(term, i, keywordPenalty)
 		}
 	}
-	return &SearchIndex{
-		options:        options,
-		keywordStrings: keywordStrings,
-		keywordIndexes: keywordIndexes,
+	if localizedInfos := localized(locale); localizedInfos != nil {
+		for i, info := range infos {
+			localizedInfo, ok := localizedInfos[strings.ToLower(info.Unified)]
+			if !ok {
+				continue
+			}
+			k.add(strings.ToLower(localizedInfo.Name), i, 0)
+			for _, term := range localizedInfo.Keywords {
+				k.add(term, i, keywordPenalty)
+			}
+		}
 	}
+	return k
+}
+
+func (k *searchKeywords) add(term string, index, penalty int) {
+	k.strings = append(k.strings, term)
+	k.indexes = append(k.indexes, index)
+	k.penalties = append(k.penalties, penalty)
+}
+
+// current returns the keywords of the emojis, rebuilding them if the registry changed.
+func (si *SearchIndex) current() *searchKeywords {
+	if si.registry == nil {
+		return si.keywords
+	}
+	infos, version := si.registry.snapshot()
+	si.mu.Lock()
+	defer si.mu.Unlock()
+	if si.version != version {
+		si.keywords = newSearchKeywords(infos, si.options.Locale)
+		si.version = version
+	}
+	return si.keywords
 }
 
 // Search performs a fuzzy search on the emoji keywords to find a matching symbol.
@@ -48,36 +106,177 @@ func NewSearchIndex(opts ...SearchOption) *SearchIndex {
 // Options provided directly to the search term override the defaults passed
 // to NewSearchIndex.
 func (si *SearchIndex) Search(query string, opts ...SearchOption) []Info {
-	ranks := fuzzy.RankFindNormalizedFold(query, si.keywordStrings)
-	sort.Sort(ranks)
+	results := si.SearchResults(query, opts...)
+	infos := make([]Info, len(results))
+	for i, result := range results {
+		infos[i] = result.Info
+	}
+	return infos
+}
 
+// SearchResults performs the same search as Search and returns details
+// about how each emoji matched the query.
+//
+// Every emoji is returned at most once and is ranked by its best matching keyword.
+// Emojis whose keywords match equally well are ordered by the configured
+// TieBreaker, or by their order in the dataset.
+func (si *SearchIndex) SearchResults(query string, opts ...SearchOption) []Result {
 	options := si.options
 	for _, optionFunc := range opts {
 		optionFunc(&options)
 	}
 
-	results := make([]Info, 0, options.Limit)
-	for _, rank := range ranks {
+	keywords := si.current()
+	results := make([]Result, 0, options.Limit)
+	for _, rank := range keywords.rank(query, options) {
 		if options.MaxDistance > 0 && rank.Distance > options.MaxDistance {
 			break
 		}
-		idx := si.keywordIndexes[rank.OriginalIndex]
-		if idx < len(All) {
-			results = append(results, All[idx])
-		}
-		if options.Limit > 0 && len(results) >= options.Limit {
+		results = append(results, Result{
+			Info:     keywords.infos[keywords.indexes[rank.OriginalIndex]],
+			Keyword:  rank.Target,
+			Distance: rank.Distance,
+			Score:    score(rank.Source, rank.Target, rank.Distance),
+			Ranges:   matchRanges(rank.Source, rank.Target),
+		})
+		if options.Ranker == nil && options.Limit > 0 && len(results) >= options.Limit {
 			break
 		}
 	}
+	if options.Ranker == nil {
+		return results
+	}
+
+	// the ranker may move any result into the limit, so all results are scored
+	for i := range results {
+		results[i].Score = options.Ranker(results[i])
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+	if options.Limit > 0 && len(results) > options.Limit {
+		results = results[:options.Limit]
+	}
 	return results
 }
 
+// rank returns the best matching keyword of every emoji that matches the query
+// ordered from best to worst.
+func (k *searchKeywords) rank(query string, options searchOptionSet) fuzzy.Ranks {
+	var (
+		ranks fuzzy.Ranks
+		// position in ranks of the keyword for an index in infos
+		positions = map[int]int{}
+	)
+	for _, rank := range fuzzy.RankFindNormalizedFold(strings.ToLower(query), k.strings) {
+		idx := k.indexes[rank.OriginalIndex]
+		if options.Filter != nil && !options.Filter(k.infos[idx]) {
+			continue
+		}
+		rank.Distance += k.penalties[rank.OriginalIndex]
+		if pos, ok := positions[idx]; ok {
+			if rank.Distance < ranks[pos].Distance {
+				ranks[pos] = rank
+			}
+			continue
+		}
+		positions[idx] = len(ranks)
+		ranks = append(ranks, rank)
+	}
+
+	// keywords are in dataset order, which a stable sort retains for ties
+	sort.SliceStable(ranks, func(i, j int) bool {
+		if ranks[i].Distance != ranks[j].Distance {
+			return ranks[i].Distance < ranks[j].Distance
+		}
+		if options.TieBreaker == nil {
+			return false
+		}
+		a := k.infos[k.indexes[ranks[i].OriginalIndex]]
+		b := k.infos[k.indexes[ranks[j].OriginalIndex]]
+		return options.TieBreaker(a, b)
+	})
+	return ranks
+}
+
+// Result is an emoji that matched a search query.
+type Result struct {
+	// Info is the matching emoji.
+	Info Info `json:"info"`
+	// Keyword is the name of the emoji that matched the query.
+	Keyword string `json:"keyword"`
+	// Distance is the Levenshtein distance between the query and the keyword.
+	// Descriptive keywords have a higher distance than names that match equally well.
+	Distance int `json:"distance"`
+	// Score is the quality of the match between 0 and 1, where 1 is an exact match,
+	// or the score returned by the Ranker if one is configured.
+	Score float64 `json:"score"`
+	// Ranges are the runes of the keyword that matched the query.
+	Ranges []Range `json:"ranges"`
+}
+
+// Range is a half-open range of rune indexes in a string.
+type Range struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// score normalizes the Levenshtein distance to a value between 0 and 1.
+func score(query, keyword string, distance int) float64 {
+	length := utf8.RuneCountInString(query)
+	if n := utf8.RuneCountInString(keyword); n > length {
+		length = n
+	}
+	if length == 0 {
+		return 1
+	}
+	return 1 - float64(distance)/float64(length)
+}
+
+// matchRanges finds the runes of the keyword that match the runes of the query
+// in order, ignoring case, and merges adjacent runes into ranges.
+// A query that is contained in the keyword results in a single range.
+func matchRanges(query, keyword string) []Range {
+	query, keyword = strings.ToLower(query), strings.ToLower(keyword)
+	if len(query) == 0 {
+		return nil
+	}
+	if idx := strings.Index(keyword, query); idx >= 0 {
+		start := utf8.RuneCountInString(keyword[:idx])
+		return []Range{{Start: start, End: start + utf8.RuneCountInString(query)}}
+	}
+
+	var ranges []Range
+	queryRunes := []rune(query)
+	q := 0
+	for i, r := range []rune(keyword) {
+		if q >= len(queryRunes) {
+			break
+		}
+		if r != queryRunes[q] {
+			continue
+		}
+		q++
+		if n := len(ranges); n > 0 && ranges[n-1].End == i {
+			ranges[n-1].End++
+			continue
+		}
+		ranges = append(ranges, Range{Start: i, End: i + 1})
+	}
+	return ranges
+}
+
 // searchOptionSet collects values from multiple search options.
 // It is internal so consumers need to use the `WithXX(...)`
 // utilities to modify an option set.
 type searchOptionSet struct {
 	MaxDistance int
 	Limit       int
+	TieBreaker  TieBreaker
+	Locale      language.Tag
+	Dataset     []Info
+	Filter      func(Info) bool
+	Ranker      Ranker
 }
 
 // SearchOption represents an option that is used to search the dataset.
@@ -99,3 +298,108 @@ func WithLimit(limit int) SearchOption {
 		option.Limit = limit
 	}
 }
+
+// WithLocale adds the emoji names and keywords in the language that best matches
+// the tag to a new SearchIndex, in addition to the English names.
+// It has no effect on the options of a single search.
+func WithLocale(tag language.Tag) SearchOption {
+	return func(option *searchOptionSet) {
+		option.Locale = tag
+	}
+}
+
+// WithDataset creates a SearchIndex of the emojis in the dataset instead of All,
+// e.g. a subset of All or a dataset that was loaded at runtime.
+// The dataset must not be modified while the index is in use.
+// It has no effect on the options of a single search or on a SearchIndex
+// created from a Registry.
+func WithDataset(dataset []Info) SearchOption {
+	return func(option *searchOptionSet) {
+		option.Dataset = dataset
+	}
+}
+
+// WithFilter restricts the search to emojis for which the filter returns true.
+// Filters are applied before the limit, and multiple filters must all match.
+func WithFilter(filter func(Info) bool) SearchOption {
+	return func(option *searchOptionSet) {
+		previous := option.Filter
+		if previous == nil {
+			option.Filter = filter
+			return
+		}
+		option.Filter = func(info Info) bool {
+			return previous(info) && filter(info)
+		}
+	}
+}
+
+// WithCategory restricts the search to emojis in one of the categories.
+func WithCategory(categories ...Category) SearchOption {
+	return WithFilter(func(info Info) bool {
+		for _, category := range categories {
+			if info.Category == category {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// WithMaxVersion restricts the search to emojis that were added in
+// the emoji version or earlier.
+func WithMaxVersion(version Version) SearchOption {
+	return WithFilter(func(info Info) bool {
+		return info.Version().Compare(version) <= 0
+	})
+}
+
+// WithPlatform restricts the search to emojis that are supported on all the platforms.
+func WithPlatform(platforms ...Platform) SearchOption {
+	return WithFilter(func(info Info) bool {
+		for _, platform := range platforms {
+			if !info.PlatformSupport.Has(platform) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// WithSkinToneSupport restricts the search to emojis that either support
+// skin tone modifiers or do not support them.
+func WithSkinToneSupport(supported bool) SearchOption {
+	return WithFilter(func(info Info) bool {
+		return (len(info.SkinVariations) > 0) == supported
+	})
+}
+
+// Ranker returns the score of a search result, where results with higher scores
+// are ranked first. The Score of the result is the fuzzy match score.
+type Ranker func(result Result) float64
+
+// WithRanker orders the results by the scores of the ranker instead of
+// the Levenshtein distance, e.g. to rank frequently used emojis with UsageRanker.
+func WithRanker(ranker Ranker) SearchOption {
+	return func(option *searchOptionSet) {
+		option.Ranker = ranker
+	}
+}
+
+// TieBreaker reports whether emoji a should be ranked before emoji b
+// when both match a search query equally well.
+type TieBreaker func(a, b Info) bool
+
+// BySortOrder is a TieBreaker that ranks emojis in the official emoji order.
+func BySortOrder(a, b Info) bool {
+	return a.SortOrder < b.SortOrder
+}
+
+// WithTieBreaker sets how emojis that match a search query equally well are ranked,
+// e.g. BySortOrder or by how popular the emojis are.
+// By default they are ranked by their order in the dataset.
+func WithTieBreaker(less TieBreaker) SearchOption {
+	return func(option *searchOptionSet) {
+		option.TieBreaker = less
+	}
+}
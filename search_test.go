@@ -6,7 +6,7 @@ import (
 )
 
 func exactMatch(s string) Info {
-	for _, info := range All {
+	for _, info := range All() {
 		if info.Name == s || info.Unified == s || info.Character == s {
 			return info
 		}
@@ -57,3 +57,187 @@ func TestSearch(t *testing.T) {
 		})
 	}
 }
+
+func TestSearchResults(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		keyword string
+		score   float64
+		ranges  []Range
+	}{
+		{
+			"exact match",
+			"rocket",
+			"rocket",
+			1,
+			[]Range{{0, 6}},
+		},
+		{
+			"case insensitive match",
+			"UP",
+			"up",
+			1,
+			[]Range{{0, 2}},
+		},
+		{
+			"contained match",
+			"humbsup",
+			"thumbsup",
+			0.875,
+			[]Range{{1, 8}},
+		},
+		{
+			"fuzzy match",
+			"thup",
+			"thumbsup",
+			0.5,
+			[]Range{{0, 3}, {7, 8}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := NewSearchIndex().SearchResults(tt.query, WithLimit(1))
+			if len(results) != 1 {
+				t.Fatalf("SearchResults() returned %d results, want 1", len(results))
+			}
+			got := results[0]
+			if got.Keyword != tt.keyword {
+				t.Errorf("SearchResults() keyword = %v, want %v", got.Keyword, tt.keyword)
+			}
+			if got.Score != tt.score {
+				t.Errorf("SearchResults() score = %v, want %v", got.Score, tt.score)
+			}
+			if !reflect.DeepEqual(got.Ranges, tt.ranges) {
+				t.Errorf("SearchResults() ranges = %v, want %v", got.Ranges, tt.ranges)
+			}
+		})
+	}
+}
+
+func TestSearchUnique(t *testing.T) {
+	for _, query := range []string{"us", "face", "heart"} {
+		t.Run(query, func(t *testing.T) {
+			seen := map[string]bool{}
+			for _, result := range NewSearchIndex().SearchResults(query) {
+				if seen[result.Info.Unified] {
+					t.Errorf("Search() returned %s more than once", result.Info)
+				}
+				seen[result.Info.Unified] = true
+			}
+		})
+	}
+}
+
+func TestSearchTieBreaker(t *testing.T) {
+	reverse := func(a, b Info) bool {
+		return a.Unified > b.Unified
+	}
+	got := NewSearchIndex(WithTieBreaker(reverse)).Search("rock", WithLimit(5), WithMaxDistance(10))
+	want := exactMatches("rock", "rocket", "shamrock", "timer_clock", "alarm_clock")
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Search() = %v, want %v", got, want)
+	}
+}
+
+func TestSearchKeywords(t *testing.T) {
+	dataset := []Info{
+		{Name: "rocket", AlternateNames: []string{"rocket"}, Keywords: []string{"space"}},
+		{Name: "space_invader", AlternateNames: []string{"space_invader"}},
+		{Name: "spacer", AlternateNames: []string{"spacer"}},
+		{Name: "space", AlternateNames: []string{"space"}},
+	}
+
+	results := NewSearchIndex(WithDataset(dataset)).SearchResults("space", WithMaxDistance(1))
+	var got []string
+	for _, result := range results {
+		got = append(got, result.Info.Name+":"+result.Keyword)
+	}
+	// an exact keyword ranks below an exact name and ties with names at distance 1
+	want := []string{"space:space", "rocket:space", "spacer:spacer"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SearchResults() = %v, want %v", got, want)
+	}
+}
+
+func TestSearchKeywords_Dataset(t *testing.T) {
+	rocket := exactMatch("rocket")
+	if len(rocket.Keywords) == 0 {
+		t.Skip("the dataset has no CLDR keywords, regenerate it with go generate")
+	}
+	for _, info := range NewSearchIndex().Search("launch") {
+		if info.Name == rocket.Name {
+			return
+		}
+	}
+	t.Errorf("Search() did not match rocket")
+}
+
+func TestSearchFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		option  SearchOption
+		matches func(Info) bool
+	}{
+		{"category", WithCategory(CategoryAnimalsAndNature), func(info Info) bool {
+			return info.Category == CategoryAnimalsAndNature
+		}},
+		{"max version", WithMaxVersion(Version{1, 0}), func(info Info) bool {
+			return info.AddedIn == "0.6" || info.AddedIn == "0.7" || info.AddedIn == "1.0"
+		}},
+		{"platform", WithPlatform(PlatformFacebook, PlatformGoogle), func(info Info) bool {
+			return info.PlatformSupport.Has(PlatformFacebook) && info.PlatformSupport.Has(PlatformGoogle)
+		}},
+		{"skin tone support", WithSkinToneSupport(true), func(info Info) bool {
+			return len(info.SkinVariations) > 0
+		}},
+		{"no skin tone support", WithSkinToneSupport(false), func(info Info) bool {
+			return len(info.SkinVariations) == 0
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewSearchIndex().Search("face", WithLimit(5), tt.option)
+			if len(got) != 5 {
+				t.Errorf("Search() returned %d results, want 5", len(got))
+			}
+			for _, info := range got {
+				if !tt.matches(info) {
+					t.Errorf("Search() returned %s, which does not match the filter", info.Name)
+				}
+			}
+		})
+	}
+}
+
+func TestSearchFiltersCombined(t *testing.T) {
+	got := NewSearchIndex().Search("face", WithCategory(CategoryAnimalsAndNature), WithSkinToneSupport(true))
+	if len(got) != 0 {
+		t.Errorf("Search() = %v, want no results", got)
+	}
+}
+
+func TestSearchDataset(t *testing.T) {
+	animals := InCategory(CategoryAnimalsAndNature)
+	custom := []Info{{Name: "rocket", AlternateNames: []string{"rocket"}}}
+	tests := []struct {
+		name    string
+		dataset []Info
+		query   string
+		want    []Info
+	}{
+		{"all", nil, "rocket", exactMatches("rocket")},
+		{"subset", animals, "rocket", []Info{}},
+		{"subset match", animals, "octopus", exactMatches("octopus")},
+		{"fixture", custom, "rocket", custom},
+		{"empty", []Info{}, "rocket", []Info{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewSearchIndex(WithDataset(tt.dataset)).Search(tt.query, WithMaxDistance(1))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Search() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
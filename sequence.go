@@ -0,0 +1,116 @@
+package emoji
+
+import "unicode/utf8"
+
+const (
+	// emojiPresentationSelector requests an emoji presentation for the preceding character.
+	emojiPresentationSelector = '\uFE0F'
+	// textPresentationSelector requests a text presentation for the preceding character.
+	textPresentationSelector = '\uFE0E'
+)
+
+// sequenceMatch identifies the emoji that an emoji codepoint sequence represents.
+type sequenceMatch struct {
+	// index of the emoji in All.
+	index int
+	// modifier is the modifier of the variation that was matched.
+	modifier Modifier
+}
+
+// sequenceNode is a node in a trie of emoji codepoint sequences.
+type sequenceNode struct {
+	children map[rune]*sequenceNode
+	// match is set if a sequence ends at this node.
+	match *sequenceMatch
+}
+
+// newSequenceTrie creates a trie containing the characters of all emojis
+// and their skin tone variations.
+//
+// It also returns the maximum number of bytes that need to be inspected
+// to find the longest match in text.
+func newSequenceTrie(infos []Info) (*sequenceNode, int) {
+	root := &sequenceNode{}
+	maxLength := 0
+	add := func(image ImageData, match sequenceMatch) {
+		for _, sequence := range image.characters() {
+			root.insert(sequence, match)
+			if len(sequence) > maxLength {
+				maxLength = len(sequence)
+			}
+		}
+	}
+	for i, info := range infos {
+		add(info.ImageData, sequenceMatch{index: i})
+		for mod, variation := range info.SkinVariations {
+			add(variation, sequenceMatch{index: i, modifier: mod})
+		}
+	}
+	// trailing presentation selectors are inspected as well
+	maxLength += 2 * utf8.RuneLen(emojiPresentationSelector)
+	return root, maxLength
+}
+
+// insert adds a sequence to the trie.
+// The first match inserted for a sequence is kept.
+//
+// Emoji presentation selectors are not stored so that fully-qualified
+// and non-qualified sequences share the same path.
+func (n *sequenceNode) insert(sequence string, match sequenceMatch) {
+	node := n
+	for _, r := range sequence {
+		if r == emojiPresentationSelector {
+			continue
+		}
+		child, ok := node.children[r]
+		if !ok {
+			if node.children == nil {
+				node.children = map[rune]*sequenceNode{}
+			}
+			child = &sequenceNode{}
+			node.children[r] = child
+		}
+		node = child
+	}
+	if node != n && node.match == nil {
+		node.match = &match
+	}
+}
+
+// longestMatch finds the longest sequence in the trie that the text starts with.
+// It returns the match and its length in bytes.
+//
+// Emoji presentation selectors within the text are optional and are included
+// in the length when they directly follow a match. A match that is followed by
+// a text presentation selector is rejected.
+func (n *sequenceNode) longestMatch(text string) (sequenceMatch, int, bool) {
+	var (
+		match  sequenceMatch
+		length int
+		found  bool
+	)
+	node := n
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		if r == emojiPresentationSelector && node != n {
+			if found && length == i {
+				length += size
+			}
+			i += size
+			continue
+		}
+		child, ok := node.children[r]
+		if !ok {
+			break
+		}
+		node = child
+		i += size
+		if node.match != nil {
+			match, length, found = *node.match, i, true
+		}
+	}
+	if r, _ := utf8.DecodeRuneInString(text[length:]); found && r == textPresentationSelector {
+		return sequenceMatch{}, 0, false
+	}
+	return match, length, found
+}
@@ -0,0 +1,145 @@
+package emoji
+
+import (
+	"io"
+	"strings"
+)
+
+// readBufferSize is the number of bytes a Reader requests from the underlying reader.
+const readBufferSize = 4096
+
+// Conversion selects the direction of a streaming conversion.
+type Conversion int
+
+const (
+	// ToEmoji converts shortcodes to emoji characters.
+	ToEmoji Conversion = iota
+	// ToShortcode converts emoji characters to shortcodes.
+	ToShortcode
+)
+
+// convertFunc writes the converted text to the builder and returns the number
+// of bytes that were consumed. Unless atEOF is set, trailing bytes that could
+// be part of a longer match are left unconsumed.
+type convertFunc func(sb *strings.Builder, text string, atEOF bool) int
+
+func (e *Emojizer) converter(c Conversion) convertFunc {
+	if c == ToShortcode {
+		return e.demojize
+	}
+	return e.emojize
+}
+
+// NewWriter creates a Writer that converts text with the default Emojizer.
+func NewWriter(w io.Writer, c Conversion) *Writer {
+	return getDefaultEmojizer().NewWriter(w, c)
+}
+
+// NewReader creates a Reader that converts text with the default Emojizer.
+func NewReader(r io.Reader, c Conversion) *Reader {
+	return getDefaultEmojizer().NewReader(r, c)
+}
+
+// NewWriter creates a Writer that converts text before writing it to w.
+func (e *Emojizer) NewWriter(w io.Writer, c Conversion) *Writer {
+	return &Writer{
+		w:       w,
+		convert: e.converter(c),
+	}
+}
+
+// NewReader creates a Reader that converts text read from r.
+func (e *Emojizer) NewReader(r io.Reader, c Conversion) *Reader {
+	return &Reader{
+		r:       r,
+		convert: e.converter(c),
+	}
+}
+
+// Writer is an io.WriteCloser that converts text on the fly.
+//
+// Text that could be part of a shortcode or emoji sequence continuing in the
+// next call to Write is buffered, so sequences split across writes are still
+// converted. Close must be called to write the buffered text.
+//
+// Converted text that the underlying writer fails to accept is kept and
+// written again by the next call to Write or Close.
+type Writer struct {
+	w       io.Writer
+	convert convertFunc
+	// pending contains written bytes that have not been converted yet.
+	pending []byte
+	// converted contains converted bytes that have not been written yet.
+	converted []byte
+}
+
+// Write converts the complete sequences in p and writes them to the underlying writer.
+// Implements the io.Writer interface.
+//
+// p is always consumed, even if writing to the underlying writer fails,
+// so it must not be written again after an error.
+func (w *Writer) Write(p []byte) (int, error) {
+	w.pending = append(w.pending, p...)
+	return len(p), w.flush(false)
+}
+
+// Close converts and writes any buffered text.
+// The underlying writer is not closed.
+func (w *Writer) Close() error {
+	return w.flush(true)
+}
+
+func (w *Writer) flush(atEOF bool) error {
+	sb := strings.Builder{}
+	n := w.convert(&sb, string(w.pending), atEOF)
+	w.pending = append(w.pending[:0], w.pending[n:]...)
+	w.converted = append(w.converted, sb.String()...)
+	if len(w.converted) == 0 {
+		return nil
+	}
+	n, err := w.w.Write(w.converted)
+	w.converted = append(w.converted[:0], w.converted[n:]...)
+	if err == nil && len(w.converted) > 0 {
+		err = io.ErrShortWrite
+	}
+	return err
+}
+
+// Reader is an io.Reader that converts text on the fly.
+//
+// Text that could be part of a shortcode or emoji sequence continuing in the
+// next read from the underlying reader is held back until it is complete.
+type Reader struct {
+	r       io.Reader
+	convert convertFunc
+	buf     []byte
+	// pending contains read bytes that have not been converted yet.
+	pending []byte
+	// converted contains converted bytes that have not been returned yet.
+	converted []byte
+	err       error
+}
+
+// Read reads converted text into p.
+// Implements the io.Reader interface.
+func (r *Reader) Read(p []byte) (int, error) {
+	for len(r.converted) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		if r.buf == nil {
+			r.buf = make([]byte, readBufferSize)
+		}
+		n, err := r.r.Read(r.buf)
+		r.pending = append(r.pending, r.buf[:n]...)
+
+		sb := strings.Builder{}
+		consumed := r.convert(&sb, string(r.pending), err != nil)
+		r.pending = append(r.pending[:0], r.pending[consumed:]...)
+		r.converted = []byte(sb.String())
+		r.err = err
+	}
+	n := copy(p, r.converted)
+	r.converted = r.converted[n:]
+	return n, nil
+}
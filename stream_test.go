@@ -0,0 +1,98 @@
+package emoji
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+var streamTests = []struct {
+	name       string
+	conversion Conversion
+	text       string
+	want       string
+}{
+	{
+		"shortcodes",
+		ToEmoji,
+		"launch :rocket: and :wave::skin-tone-4: :unknown: 10:30",
+		"launch 🚀 and 👋🏽 :unknown: 10:30",
+	},
+	{
+		"emoji",
+		ToShortcode,
+		"family 👨‍👩‍👧 waves 👋🏽 at #️⃣",
+		"family :man_woman_girl: waves :wave::skin-tone-medium: at :hash:",
+	},
+}
+
+func TestWriter(t *testing.T) {
+	for _, tt := range streamTests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			w := NewWriter(buf, tt.conversion)
+			// write one byte at a time to split every sequence
+			for i := 0; i < len(tt.text); i++ {
+				if _, err := w.Write([]byte{tt.text[i]}); err != nil {
+					t.Fatalf("Write() error = %v", err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("Close() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("Writer wrote %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// failingWriter fails the first writes and then writes to the buffer.
+type failingWriter struct {
+	bytes.Buffer
+	failures int
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if w.failures > 0 {
+		w.failures--
+		return 0, errors.New("write failed")
+	}
+	return w.Buffer.Write(p)
+}
+
+func TestWriter_WriteError(t *testing.T) {
+	buf := &failingWriter{failures: 1}
+	w := NewWriter(buf, ToEmoji)
+	n, err := w.Write([]byte("launch :rocket: "))
+	if err == nil || n != len("launch :rocket: ") {
+		t.Fatalf("Write() = %v, %v, want %v and an error", n, err, len("launch :rocket: "))
+	}
+	if _, err := w.Write([]byte(":wave:")); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if got, want := buf.String(), "launch 🚀 👋"; got != want {
+		t.Errorf("Writer wrote %q, want %q", got, want)
+	}
+}
+
+func TestReader(t *testing.T) {
+	for _, tt := range streamTests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewReader(iotest.OneByteReader(strings.NewReader(tt.text)), tt.conversion)
+			got, err := ioutil.ReadAll(r)
+			if err != nil {
+				t.Fatalf("ReadAll() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Reader read %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package emoji
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// usageHalfLife is the time after which a use counts half as much towards the frecency.
+const usageHalfLife = 7 * 24 * time.Hour
+
+// UsageTracker records which emojis a user picks so that they can be ranked
+// by frecency, a combination of how frequently and how recently they were used.
+type UsageTracker interface {
+	// Record records that the emoji with the name was used at the time.
+	Record(name string, at time.Time) error
+	// Usage returns the usage of the emoji with the name,
+	// which is the zero value if the emoji was never used.
+	Usage(name string) Usage
+	// Usages returns the usage of all emojis that were used.
+	Usages() []Usage
+}
+
+// Usage describes how often and how recently an emoji was used.
+type Usage struct {
+	// Name is the name of the emoji.
+	Name string `json:"name"`
+	// Count is the number of times the emoji was used.
+	Count int `json:"count"`
+	// LastUsed is the time the emoji was last used.
+	LastUsed time.Time `json:"last_used"`
+	// Weight is the count with older uses decayed as of LastUsed.
+	Weight float64 `json:"weight"`
+}
+
+// Frecency returns the decayed count of uses at the given time,
+// where every use counts half as much after each week.
+func (u Usage) Frecency(now time.Time) float64 {
+	age := now.Sub(u.LastUsed)
+	if age < 0 {
+		age = 0
+	}
+	return u.Weight * math.Exp2(-float64(age)/float64(usageHalfLife))
+}
+
+// record returns the usage after another use at the time.
+func (u Usage) record(at time.Time) Usage {
+	if at.Before(u.LastUsed) {
+		at = u.LastUsed
+	}
+	u.Weight = u.Frecency(at) + 1
+	u.Count++
+	u.LastUsed = at
+	return u
+}
+
+// Recent returns up to n emojis with the highest frecency, which is suitable
+// for a "frequently used" row. A value of 0 for n means no limit.
+//
+// Uses recorded under different names of the same emoji count towards it once.
+func Recent(tracker UsageTracker, n int) []Info {
+	type recent struct {
+		info     Info
+		frecency float64
+		lastUsed time.Time
+	}
+	now := time.Now()
+	var recents []*recent
+	byUnified := map[string]*recent{}
+	for _, usage := range tracker.Usages() {
+		info, _, ok := ByName(usage.Name)
+		if !ok {
+			continue
+		}
+		r, ok := byUnified[info.Unified]
+		if !ok {
+			r = &recent{info: info}
+			byUnified[info.Unified] = r
+			recents = append(recents, r)
+		}
+		r.frecency += usage.Frecency(now)
+		if usage.LastUsed.After(r.lastUsed) {
+			r.lastUsed = usage.LastUsed
+		}
+	}
+	sort.SliceStable(recents, func(i, j int) bool {
+		a, b := recents[i], recents[j]
+		if a.frecency != b.frecency {
+			return a.frecency > b.frecency
+		}
+		return a.lastUsed.After(b.lastUsed)
+	})
+	if n > 0 && len(recents) > n {
+		recents = recents[:n]
+	}
+	var infos []Info
+	for _, r := range recents {
+		infos = append(infos, r.info)
+	}
+	return infos
+}
+
+// UsageRanker returns a Ranker that blends the fuzzy score of a search result
+// with the frecency of the emoji. The weight between 0 and 1 is the share
+// of the usage in the blended score.
+//
+// Uses recorded under any of the alternate names of an emoji count towards it.
+func UsageRanker(tracker UsageTracker, weight float64) Ranker {
+	return func(result Result) float64 {
+		now := time.Now()
+		frecency := 0.0
+		for _, name := range usageNames(result.Info) {
+			frecency += tracker.Usage(name).Frecency(now)
+		}
+		return (1-weight)*result.Score + weight*frecency/(frecency+1)
+	}
+}
+
+// usageNames returns the names that uses of the emoji may be recorded under.
+func usageNames(info Info) []string {
+	for _, name := range info.AlternateNames {
+		if name == info.Name {
+			return info.AlternateNames
+		}
+	}
+	return append([]string{info.Name}, info.AlternateNames...)
+}
+
+// MemoryUsageTracker is a UsageTracker that keeps the usage in memory.
+// It is safe for concurrent use.
+type MemoryUsageTracker struct {
+	mu     sync.RWMutex
+	usages map[string]Usage
+}
+
+// NewMemoryUsageTracker creates an empty in-memory UsageTracker.
+func NewMemoryUsageTracker() *MemoryUsageTracker {
+	return &MemoryUsageTracker{usages: map[string]Usage{}}
+}
+
+// Record implements UsageTracker.
+func (m *MemoryUsageTracker) Record(name string, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	usage := m.usages[name]
+	usage.Name = name
+	m.usages[name] = usage.record(at)
+	return nil
+}
+
+// Usage implements UsageTracker.
+func (m *MemoryUsageTracker) Usage(name string) Usage {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.usages[name]
+}
+
+// Usages implements UsageTracker and returns the usages ordered by name.
+func (m *MemoryUsageTracker) Usages() []Usage {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	usages := make([]Usage, 0, len(m.usages))
+	for _, usage := range m.usages {
+		usages = append(usages, usage)
+	}
+	sort.Slice(usages, func(i, j int) bool {
+		return usages[i].Name < usages[j].Name
+	})
+	return usages
+}
+
+// FileUsageTracker is a UsageTracker that persists the usage to a JSON file
+// after every recorded use. It is safe for concurrent use within a process.
+type FileUsageTracker struct {
+	*MemoryUsageTracker
+	path string
+	// mu serializes writes to the file.
+	mu sync.Mutex
+}
+
+// NewFileUsageTracker creates a UsageTracker that is stored in the JSON file
+// at the path. The usage is loaded from the file if it exists.
+func NewFileUsageTracker(path string) (*FileUsageTracker, error) {
+	tracker := &FileUsageTracker{
+		MemoryUsageTracker: NewMemoryUsageTracker(),
+		path:               path,
+	}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return tracker, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed reading usage %s: %w", path, err)
+	}
+	var usages []Usage
+	if err := json.Unmarshal(data, &usages); err != nil {
+		return nil, fmt.Errorf("failed parsing usage %s: %w", path, err)
+	}
+	for _, usage := range usages {
+		tracker.usages[usage.Name] = usage
+	}
+	return tracker, nil
+}
+
+// Record implements UsageTracker and writes the usage to the file.
+func (f *FileUsageTracker) Record(name string, at time.Time) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.MemoryUsageTracker.Record(name, at); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(f.Usages(), "", "  ")
+	if err != nil {
+		return err
+	}
+	// replace the file atomically so that it is never partially written
+	tmp := f.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("failed writing usage %s: %w", tmp, err)
+	}
+	if err := os.Rename(tmp, f.path); err != nil {
+		return fmt.Errorf("failed writing usage %s: %w", f.path, err)
+	}
+	return nil
+}
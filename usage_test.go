@@ -0,0 +1,142 @@
+package emoji
+
+import (
+	"math"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestUsage_Frecency(t *testing.T) {
+	now := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		uses []time.Duration
+		want float64
+	}{
+		{"unused", nil, 0},
+		{"once now", []time.Duration{0}, 1},
+		{"once a week ago", []time.Duration{-usageHalfLife}, 0.5},
+		{"twice", []time.Duration{-usageHalfLife, 0}, 1.5},
+		{"out of order", []time.Duration{0, -usageHalfLife}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var usage Usage
+			for _, use := range tt.uses {
+				usage = usage.record(now.Add(use))
+			}
+			if got := usage.Frecency(now); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Frecency() = %v, want %v", got, tt.want)
+			}
+			if usage.Count != len(tt.uses) {
+				t.Errorf("Count = %v, want %v", usage.Count, len(tt.uses))
+			}
+		})
+	}
+}
+
+func TestRecent(t *testing.T) {
+	now := time.Now()
+	tracker := NewMemoryUsageTracker()
+	_ = tracker.Record("rocket", now.Add(-time.Hour))
+	_ = tracker.Record("wave", now.Add(-4*usageHalfLife))
+	_ = tracker.Record("wave", now.Add(-4*usageHalfLife))
+	_ = tracker.Record("heart", now)
+	_ = tracker.Record("heart", now)
+	_ = tracker.Record("fubar", now)
+
+	tests := []struct {
+		n    int
+		want []Info
+	}{
+		{0, exactMatches("heart", "rocket", "wave")},
+		{2, exactMatches("heart", "rocket")},
+	}
+	for _, tt := range tests {
+		if got := Recent(tracker, tt.n); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Recent(%d) = %v, want %v", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestRecent_AlternateNames(t *testing.T) {
+	now := time.Now()
+	tracker := NewMemoryUsageTracker()
+	_ = tracker.Record("+1", now)
+	_ = tracker.Record("thumbsup", now)
+	_ = tracker.Record("rocket", now)
+	_ = tracker.Record("rocket", now.Add(-time.Hour))
+	_ = tracker.Record("rocket", now.Add(-2*time.Hour))
+
+	thumbsUp, _, _ := ByName("thumbsup")
+	// the uses of both names add up, but rank below the three rocket uses
+	if got, want := Recent(tracker, 5), []Info{exactMatch("rocket"), thumbsUp}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Recent(5) = %v, want %v", got, want)
+	}
+	// two uses of each name of barely_sunny outrank the three rocket uses
+	for i := 0; i < 2; i++ {
+		_ = tracker.Record("sun_behind_cloud", now)
+		_ = tracker.Record("barely_sunny", now)
+	}
+	if got, want := Recent(tracker, 1), exactMatches("barely_sunny"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Recent(1) = %v, want %v", got, want)
+	}
+}
+
+func TestFileUsageTracker(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "usage.json")
+	now := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+	tracker, err := NewFileUsageTracker(path)
+	if err != nil {
+		t.Fatalf("NewFileUsageTracker() error = %v", err)
+	}
+	for _, name := range []string{"rocket", "wave", "rocket"} {
+		if err := tracker.Record(name, now); err != nil {
+			t.Fatalf("Record() error = %v", err)
+		}
+	}
+
+	loaded, err := NewFileUsageTracker(path)
+	if err != nil {
+		t.Fatalf("NewFileUsageTracker() error = %v", err)
+	}
+	if got, want := loaded.Usages(), tracker.Usages(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Usages() = %v, want %v", got, want)
+	}
+	if got := loaded.Usage("rocket").Count; got != 2 {
+		t.Errorf("Usage().Count = %v, want 2", got)
+	}
+}
+
+func TestSearchUsageRanker(t *testing.T) {
+	tracker := NewMemoryUsageTracker()
+	for i := 0; i < 5; i++ {
+		_ = tracker.Record("alarm_clock", time.Now())
+	}
+	index := NewSearchIndex()
+
+	// without usage the closest match ranks first
+	got := index.Search("clock", WithLimit(1))
+	if want := exactMatches("clock1"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Search() = %v, want %v", got, want)
+	}
+	got = index.Search("clock", WithLimit(1), WithRanker(UsageRanker(tracker, 0.5)))
+	if want := exactMatches("alarm_clock"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Search() with UsageRanker = %v, want %v", got, want)
+	}
+}
+
+func TestSearchUsageRanker_AlternateName(t *testing.T) {
+	tracker := NewMemoryUsageTracker()
+	for i := 0; i < 5; i++ {
+		_ = tracker.Record("sun_behind_cloud", time.Now())
+	}
+	index := NewSearchIndex()
+
+	got := index.Search("sun", WithLimit(1), WithRanker(UsageRanker(tracker, 0.5)))
+	if want := exactMatches("barely_sunny"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Search() with UsageRanker = %v, want %v", got, want)
+	}
+}
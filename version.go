@@ -0,0 +1,134 @@
+package emoji
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version is an emoji version such as 12.0 or 13.1.
+//
+// Emojis that predate the emoji versions have the versions 0.6 and 0.7
+// for the Unicode versions 6.0 and 7.0 they were added in.
+type Version struct {
+	Major int
+	Minor int
+}
+
+// release describes when an emoji version was released.
+type release struct {
+	unicode Version
+	year    int
+}
+
+// releases maps the emoji versions to the Unicode version they are based on
+// and the year they were released in.
+var releases = map[Version]release{
+	{0, 6}:  {Version{6, 0}, 2010},
+	{0, 7}:  {Version{7, 0}, 2014},
+	{1, 0}:  {Version{8, 0}, 2015},
+	{2, 0}:  {Version{8, 0}, 2015},
+	{3, 0}:  {Version{9, 0}, 2016},
+	{4, 0}:  {Version{9, 0}, 2016},
+	{5, 0}:  {Version{10, 0}, 2017},
+	{11, 0}: {Version{11, 0}, 2018},
+	{12, 0}: {Version{12, 0}, 2019},
+	{12, 1}: {Version{12, 1}, 2019},
+	{13, 0}: {Version{13, 0}, 2020},
+	{13, 1}: {Version{13, 0}, 2020},
+	{14, 0}: {Version{14, 0}, 2021},
+	{15, 0}: {Version{15, 0}, 2022},
+	{15, 1}: {Version{15, 1}, 2023},
+	{16, 0}: {Version{16, 0}, 2024},
+}
+
+// ParseVersion parses a version such as "12.0", "13.1" or "12".
+func ParseVersion(s string) (Version, error) {
+	parts := strings.Split(s, ".")
+	if len(parts) > 2 {
+		return Version{}, fmt.Errorf("unrecognized version \"%s\"", s)
+	}
+	var numbers [2]int
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 || part[0] == '+' {
+			return Version{}, fmt.Errorf("unrecognized version \"%s\"", s)
+		}
+		numbers[i] = n
+	}
+	return Version{Major: numbers[0], Minor: numbers[1]}, nil
+}
+
+// Compare returns -1 if the version is lower than the other version,
+// 0 if they are equal and 1 if it is higher.
+func (v Version) Compare(other Version) int {
+	if v.Major != other.Major {
+		return compareInts(v.Major, other.Major)
+	}
+	return compareInts(v.Minor, other.Minor)
+}
+
+// String implements fmt.Stringer and returns the version such as "12.0".
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+// UnicodeVersion returns the Unicode version that the emoji version is based on,
+// or false if the emoji version is unknown.
+func (v Version) UnicodeVersion() (Version, bool) {
+	r, ok := releases[v]
+	return r.unicode, ok
+}
+
+// Year returns the year the emoji version was released,
+// or 0 if the emoji version is unknown.
+func (v Version) Year() int {
+	return releases[v].year
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// This function also determines how a version is marshaled to JSON.
+func (v Version) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// This function also determines how a version is unmarshaled from JSON.
+func (v *Version) UnmarshalText(text []byte) error {
+	parsed, err := ParseVersion(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// Version returns the emoji version the emoji was added in,
+// or the zero Version if AddedIn is not a valid version.
+func (d ImageData) Version() Version {
+	v, _ := ParseVersion(d.AddedIn)
+	return v
+}
+
+// FilterByMaxVersion returns the emojis that were added in the version or earlier,
+// which are the emojis that clients supporting the version can display.
+func FilterByMaxVersion(v Version) []Info {
+	var results []Info
+	for _, info := range All() {
+		if info.Version().Compare(v) <= 0 {
+			results = append(results, info)
+		}
+	}
+	return results
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,115 @@
+package emoji
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Version
+		wantErr bool
+	}{
+		{"12.0", Version{12, 0}, false},
+		{"13.1", Version{13, 1}, false},
+		{"0.6", Version{0, 6}, false},
+		{"12", Version{12, 0}, false},
+		{"", Version{}, true},
+		{"12.", Version{}, true},
+		{"1.2.3", Version{}, true},
+		{"-1.0", Version{}, true},
+		{"+1.0", Version{}, true},
+		{"v12", Version{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseVersion(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseVersion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_Compare(t *testing.T) {
+	tests := []struct {
+		a, b Version
+		want int
+	}{
+		{Version{12, 0}, Version{12, 0}, 0},
+		{Version{0, 6}, Version{12, 0}, -1},
+		{Version{13, 1}, Version{13, 0}, 1},
+		{Version{2, 0}, Version{11, 0}, -1},
+		{Version{}, Version{0, 6}, -1},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Compare(tt.b); got != tt.want {
+			t.Errorf("%v.Compare(%v) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestVersion_Release(t *testing.T) {
+	tests := []struct {
+		version Version
+		unicode string
+		year    int
+		ok      bool
+	}{
+		{Version{0, 6}, "6.0", 2010, true},
+		{Version{4, 0}, "9.0", 2016, true},
+		{Version{13, 1}, "13.0", 2020, true},
+		{Version{14, 0}, "14.0", 2021, true},
+		{Version{6, 0}, "0.0", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version.String(), func(t *testing.T) {
+			unicode, ok := tt.version.UnicodeVersion()
+			if unicode.String() != tt.unicode || ok != tt.ok {
+				t.Errorf("UnicodeVersion() = %v, %v, want %v, %v", unicode, ok, tt.unicode, tt.ok)
+			}
+			if got := tt.version.Year(); got != tt.year {
+				t.Errorf("Year() = %v, want %v", got, tt.year)
+			}
+		})
+	}
+}
+
+func TestVersion_JSON(t *testing.T) {
+	data, err := json.Marshal(map[string]Version{"version": {12, 1}})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if got, want := string(data), `{"version":"12.1"}`; got != want {
+		t.Errorf("Marshal() = %v, want %v", got, want)
+	}
+	var v map[string]Version
+	if err := json.Unmarshal(data, &v); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if got := v["version"]; got != (Version{12, 1}) {
+		t.Errorf("Unmarshal() = %v, want 12.1", got)
+	}
+}
+
+func TestFilterByMaxVersion(t *testing.T) {
+	max := Version{12, 0}
+	got := FilterByMaxVersion(max)
+	if len(got) == 0 || len(got) >= len(All()) {
+		t.Fatalf("FilterByMaxVersion() returned %d of %d emojis", len(got), len(All()))
+	}
+	for _, info := range got {
+		if info.Version().Compare(max) > 0 {
+			t.Errorf("FilterByMaxVersion() returned %s added in %s", info.Name, info.AddedIn)
+		}
+	}
+	for _, info := range All() {
+		if info.Version() == (Version{}) {
+			t.Errorf("Version() of %s is invalid: %q", info.Name, info.AddedIn)
+		}
+	}
+}